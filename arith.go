@@ -0,0 +1,420 @@
+package g
+
+import (
+	"fmt"
+	"math"
+	"math/bits"
+	"sort"
+)
+
+// The millerRabinBases is a set of bases for which the Miller-Rabin
+// test is deterministic for all 64-bit unsigned integers.
+var millerRabinBases = []uint64{2, 3, 5, 7, 11, 13, 17, 19, 23, 29, 31, 37}
+
+// The magnitude function returns the absolute value of
+// the integer as uint64, without overflow for the minimal
+// value of the signed types.
+func magnitude[T Integer](v T) uint64 {
+	if v < 0 {
+		return uint64(-int64(v))
+	}
+
+	return uint64(v)
+}
+
+// GCD returns the greatest common divisor of all values
+// with overflow checking.
+//
+// The result is always non-negative. The GCD of zero and n is |n|,
+// and the GCD of no values or of zeros only is 0. If the result does
+// not fit into the type T, which is possible only for the minimum
+// value of a signed type, e.g. GCD[int8](-128), the function returns
+// an error, as the LCM function does.
+//
+// Example usage:
+//
+//	d, err := g.GCD(12, 18)        // 6, nil
+//	d, err = g.GCD(-12, 18, 27)    // 3, nil
+//	d, err = g.GCD(0, 7)           // 7, nil
+//	d, err = g.GCD[int8](-128, 0)  // 0, error: int8 overflow occurred
+func GCD[T Integer](v ...T) (T, error) {
+	var result uint64
+	for _, val := range v {
+		result = gcd(result, magnitude(val))
+	}
+
+	if result > kindOf[T]().max {
+		var zero T
+		return 0, fmt.Errorf("%T overflow occurred", zero)
+	}
+
+	return T(result), nil
+}
+
+// The gcd function returns the greatest common divisor
+// of two unsigned values using Stein's algorithm.
+func gcd(a, b uint64) uint64 {
+	if a == 0 {
+		return b
+	} else if b == 0 {
+		return a
+	}
+
+	shift := bits.TrailingZeros64(a | b)
+	a >>= bits.TrailingZeros64(a)
+	for b != 0 {
+		b >>= bits.TrailingZeros64(b)
+		if a > b {
+			a, b = b, a
+		}
+		b -= a
+	}
+
+	return a << shift
+}
+
+// LCM returns the least common multiple of all values
+// with overflow checking.
+//
+// The result is always non-negative. If any value is zero, the result
+// is zero. If the result does not fit into the type T, the function
+// returns an error, as the SafeSum function does.
+//
+// Example usage:
+//
+//	l, err := g.LCM(4, 6)        // 12, nil
+//	l, err = g.LCM(-3, 5, 10)    // 30, nil
+//	l, err = g.LCM[int8](16, 9)  // 0, error: int8 overflow occurred
+func LCM[T Integer](v ...T) (T, error) {
+	if len(v) == 0 {
+		return 0, nil
+	}
+
+	max := kindOf[T]().max
+	result := uint64(1)
+	for _, val := range v {
+		m := magnitude(val)
+		if m == 0 {
+			return 0, nil
+		}
+
+		hi, lo := bits.Mul64(result/gcd(result, m), m)
+		if hi != 0 || lo > max {
+			return 0, fmt.Errorf("%T overflow occurred", val)
+		}
+		result = lo
+	}
+
+	return T(result), nil
+}
+
+// The mulMod function returns a*b mod m without overflow.
+func mulMod(a, b, m uint64) uint64 {
+	hi, lo := bits.Mul64(a, b)
+	return bits.Rem64(hi, lo, m)
+}
+
+// The powMod function returns a^e mod m without overflow.
+func powMod(a, e, m uint64) uint64 {
+	result := uint64(1)
+	a %= m
+	for e > 0 {
+		if e&1 == 1 {
+			result = mulMod(result, a, m)
+		}
+		a = mulMod(a, a, m)
+		e >>= 1
+	}
+
+	return result
+}
+
+// The isPrime function checks if n is a prime number using
+// the deterministic variant of the Miller-Rabin test.
+func isPrime(n uint64) bool {
+	if n < 2 {
+		return false
+	}
+
+	for _, p := range millerRabinBases {
+		if n%p == 0 {
+			return n == p
+		}
+	}
+
+	// Write n-1 as d*2^s with odd d.
+	d := n - 1
+	s := bits.TrailingZeros64(d)
+	d >>= s
+
+	for _, a := range millerRabinBases {
+		x := powMod(a, d, n)
+		if x == 1 || x == n-1 {
+			continue
+		}
+
+		composite := true
+		for i := 1; i < s; i++ {
+			x = mulMod(x, x, n)
+			if x == n-1 {
+				composite = false
+				break
+			}
+		}
+
+		if composite {
+			return false
+		}
+	}
+
+	return true
+}
+
+// IsPrime checks if a value is a prime number.
+//
+// The function uses the deterministic variant of the Miller-Rabin test,
+// which gives an exact answer for any 64-bit value. Negative numbers,
+// zero and one are not prime.
+//
+// Example usage:
+//
+//	g.IsPrime(97)                    // Output: true
+//	g.IsPrime(91)                    // Output: false
+//	g.IsPrime(uint64(18446744073709551557)) // Output: true
+func IsPrime[T Integer](v T) bool {
+	if v < 2 {
+		return false
+	}
+
+	return isPrime(uint64(v))
+}
+
+// The sieveSegmentSize is the size of one segment of the sieve
+// used in the PrimesUpTo function. It is chosen so that a segment
+// fits into the CPU cache.
+const sieveSegmentSize = 32768
+
+// PrimesUpTo returns all prime numbers less than or equal to n
+// in ascending order.
+//
+// The function uses a segmented sieve of Eratosthenes. For large n,
+// the segments are sieved concurrently according to the package's
+// parallel settings (see ParallelTasks).
+//
+// The function returns nil if n is greater than or equal to
+// the MaxRangeSize.
+//
+// Example usage:
+//
+//	g.PrimesUpTo(30)  // Output: [2 3 5 7 11 13 17 19 23 29]
+//	g.PrimesUpTo(1)   // Output: []
+func PrimesUpTo[T Integer](n T) []T {
+	if n < 2 {
+		return []T{}
+	} else if uint64(n) >= MaxRangeSize {
+		return nil
+	}
+
+	limit := int(n)
+	root := int(math.Sqrt(float64(limit)))
+	for root*root > limit {
+		root--
+	}
+	for (root+1)*(root+1) <= limit {
+		root++
+	}
+
+	// Base primes up to the square root of the limit.
+	composite := make([]bool, root+1)
+	base := make([]int, 0)
+	for i := 2; i <= root; i++ {
+		if composite[i] {
+			continue
+		}
+
+		base = append(base, i)
+		for j := i * i; j <= root; j += i {
+			composite[j] = true
+		}
+	}
+
+	// Sieve the segments; each chunk writes its own result only.
+	segments := (limit + sieveSegmentSize) / sieveSegmentSize
+	found := make([][]T, segments)
+	doInChunks(segments*sieveSegmentSize, func(start, end int) {
+		mark := make([]bool, sieveSegmentSize)
+		first := (start + sieveSegmentSize - 1) / sieveSegmentSize
+		for s := first; s*sieveSegmentSize < end; s++ {
+			low := s * sieveSegmentSize
+			high := low + sieveSegmentSize - 1
+			if high > limit {
+				high = limit
+			}
+
+			for i := range mark {
+				mark[i] = false
+			}
+
+			for _, p := range base {
+				j := p * p
+				if j > high {
+					break
+				} else if j < low {
+					j = (low + p - 1) / p * p
+				}
+
+				for ; j <= high; j += p {
+					mark[j-low] = true
+				}
+			}
+
+			primes := make([]T, 0)
+			for i := low; i <= high; i++ {
+				if i >= 2 && !mark[i-low] {
+					primes = append(primes, T(i))
+				}
+			}
+			found[s] = primes
+		}
+	})
+
+	result := make([]T, 0)
+	for _, primes := range found {
+		result = append(result, primes...)
+	}
+
+	return result
+}
+
+// The pollardRho function returns a non-trivial divisor of the
+// composite number n using Pollard's rho algorithm.
+func pollardRho(n uint64) uint64 {
+	if n%2 == 0 {
+		return 2
+	}
+
+	for c := uint64(1); ; c++ {
+		f := func(x uint64) uint64 {
+			x = mulMod(x, x, n)
+			if x >= n-c {
+				return x - (n - c)
+			}
+			return x + c
+		}
+		x, y, d := uint64(2), uint64(2), uint64(1)
+		for d == 1 {
+			x = f(x)
+			y = f(f(y))
+			if x > y {
+				d = gcd(x-y, n)
+			} else {
+				d = gcd(y-x, n)
+			}
+		}
+
+		if d != n {
+			return d
+		}
+	}
+}
+
+// The factorize function appends the prime factors of n to result.
+func factorize(n uint64, result []uint64) []uint64 {
+	// Small factors are removed by trial division, it's faster.
+	for _, p := range millerRabinBases {
+		for n%p == 0 {
+			result = append(result, p)
+			n /= p
+		}
+	}
+
+	if n == 1 {
+		return result
+	} else if isPrime(n) {
+		return append(result, n)
+	}
+
+	d := pollardRho(n)
+	result = factorize(d, result)
+	return factorize(n/d, result)
+}
+
+// Factorize returns the prime factors of the value in ascending
+// order, each factor is repeated according to its multiplicity.
+//
+// For negative values the factors of the absolute value are returned.
+// For 0, 1 and -1 the function returns an empty slice.
+//
+// Example usage:
+//
+//	g.Factorize(360)   // Output: [2 2 2 3 3 5]
+//	g.Factorize(-98)   // Output: [2 7 7]
+//	g.Factorize(97)    // Output: [97]
+func Factorize[T Integer](v T) []T {
+	m := magnitude(v)
+	if m < 2 {
+		return []T{}
+	}
+
+	factors := factorize(m, make([]uint64, 0))
+	sort.Slice(factors, func(i, j int) bool { return factors[i] < factors[j] })
+
+	result := make([]T, len(factors))
+	for i, f := range factors {
+		result[i] = T(f)
+	}
+
+	return result
+}
+
+// Divisors returns all positive divisors of the value in ascending
+// order, including 1 and the absolute value itself.
+//
+// For negative values the divisors of the absolute value are returned.
+// The absolute value of the minimum value of a signed type doesn't fit
+// into the type, so it's omitted, e.g. 128 for Divisors[int8](-128).
+// For 0 the function returns an empty slice.
+//
+// Example usage:
+//
+//	g.Divisors(12)          // Output: [1 2 3 4 6 12]
+//	g.Divisors(-7)          // Output: [1 7]
+//	g.Divisors(1)           // Output: [1]
+//	g.Divisors[int8](-128)  // Output: [1 2 4 8 16 32 64]
+func Divisors[T Integer](v T) []T {
+	m := magnitude(v)
+	if m == 0 {
+		return []T{}
+	}
+
+	divisors := []uint64{1}
+	factors := factorize(m, make([]uint64, 0))
+	sort.Slice(factors, func(i, j int) bool { return factors[i] < factors[j] })
+	for i := 0; i < len(factors); {
+		p, count := factors[i], 0
+		for i < len(factors) && factors[i] == p {
+			count++
+			i++
+		}
+
+		size := len(divisors)
+		power := uint64(1)
+		for k := 0; k < count; k++ {
+			power *= p
+			for _, d := range divisors[:size] {
+				divisors = append(divisors, d*power)
+			}
+		}
+	}
+
+	sort.Slice(divisors, func(i, j int) bool { return divisors[i] < divisors[j] })
+	max := kindOf[T]().max
+	result := make([]T, 0, len(divisors))
+	for _, d := range divisors {
+		if d <= max {
+			result = append(result, T(d))
+		}
+	}
+
+	return result
+}
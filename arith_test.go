@@ -0,0 +1,220 @@
+package g
+
+import (
+	"math"
+	"reflect"
+	"testing"
+)
+
+// TestGCD tests the GCD function.
+func TestGCD(t *testing.T) {
+	tests := []struct {
+		name string
+		v    []int
+		want int
+	}{
+		{"Empty", []int{}, 0},
+		{"Simple", []int{12, 18}, 6},
+		{"Negative", []int{-12, 18, 27}, 3},
+		{"Zero", []int{0, 7}, 7},
+		{"Zeros", []int{0, 0}, 0},
+		{"Coprime", []int{17, 31}, 1},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := GCD(tt.v...)
+			if err != nil || got != tt.want {
+				t.Errorf("GCD() = %v, %v, want %v", got, err, tt.want)
+			}
+		})
+	}
+
+	if got, err := GCD[int64](math.MinInt64, 6); err != nil || got != 2 {
+		t.Errorf("GCD() = %v, %v, want 2", got, err)
+	}
+
+	// The magnitude of the minimum value doesn't fit into the type.
+	if _, err := GCD[int8](-128); err == nil {
+		t.Error("GCD[int8](-128) expected an overflow error")
+	}
+
+	if _, err := GCD[int64](math.MinInt64, 0); err == nil {
+		t.Error("GCD[int64](MinInt64, 0) expected an overflow error")
+	}
+
+	if got, err := GCD[uint8](255, 0); err != nil || got != 255 {
+		t.Errorf("GCD[uint8]() = %v, %v, want 255", got, err)
+	}
+}
+
+// TestLCM tests the LCM function.
+func TestLCM(t *testing.T) {
+	tests := []struct {
+		name    string
+		v       []int
+		want    int
+		wantErr bool
+	}{
+		{"Empty", []int{}, 0, false},
+		{"Simple", []int{4, 6}, 12, false},
+		{"Negative", []int{-3, 5, 10}, 30, false},
+		{"Zero", []int{0, 5}, 0, false},
+		{"Overflow", []int{math.MaxInt, 2}, 0, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := LCM(tt.v...)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("LCM() error = %v, wantErr %v", err, tt.wantErr)
+			}
+
+			if got != tt.want {
+				t.Errorf("LCM() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+
+	if _, err := LCM[int8](16, 9); err == nil {
+		t.Errorf("LCM() expected int8 overflow error")
+	}
+
+	if got, err := LCM[uint8](15, 17); err != nil || got != 255 {
+		t.Errorf("LCM() = %v, %v, want 255, nil", got, err)
+	}
+}
+
+// TestIsPrime tests the IsPrime function.
+func TestIsPrime(t *testing.T) {
+	primes := []int{2, 3, 5, 7, 11, 13, 97, 7919}
+	for _, p := range primes {
+		if !IsPrime(p) {
+			t.Errorf("IsPrime(%d) = false, want true", p)
+		}
+	}
+
+	composites := []int{-7, 0, 1, 4, 91, 561, 1105, 7917}
+	for _, c := range composites {
+		if IsPrime(c) {
+			t.Errorf("IsPrime(%d) = true, want false", c)
+		}
+	}
+
+	if !IsPrime(uint64(18446744073709551557)) {
+		t.Errorf("IsPrime() = false for the largest 64-bit prime")
+	}
+
+	// Strong pseudoprime to bases 2, 3, 5, 7, 11, 13, 17, 19, 23.
+	if IsPrime(uint64(3825123056546413051)) {
+		t.Errorf("IsPrime() = true for a strong pseudoprime")
+	}
+}
+
+// TestPrimesUpTo tests the PrimesUpTo function.
+func TestPrimesUpTo(t *testing.T) {
+	want := []int{2, 3, 5, 7, 11, 13, 17, 19, 23, 29}
+	if got := PrimesUpTo(30); !reflect.DeepEqual(got, want) {
+		t.Errorf("PrimesUpTo() = %v, want %v", got, want)
+	}
+
+	if got := PrimesUpTo(29); !reflect.DeepEqual(got, want) {
+		t.Errorf("PrimesUpTo() = %v, want %v", got, want)
+	}
+
+	if got := PrimesUpTo(1); len(got) != 0 {
+		t.Errorf("PrimesUpTo() = %v, want []", got)
+	}
+
+	if got := PrimesUpTo(MaxRangeSize); got != nil {
+		t.Errorf("PrimesUpTo() = %v, want nil", got)
+	}
+}
+
+// TestPrimesUpToLargeData tests the PrimesUpTo function with
+// a limit large enough to trigger parallel execution.
+func TestPrimesUpToLargeData(t *testing.T) {
+	n := minLoadPerGoroutine*parallelTasks + 1001
+	primes := PrimesUpTo(n)
+	for i, p := range primes {
+		if !IsPrime(p) {
+			t.Fatalf("PrimesUpTo()[%d] = %d is not prime", i, p)
+		} else if i > 0 && primes[i-1] >= p {
+			t.Fatalf("PrimesUpTo() is not sorted at %d", i)
+		}
+	}
+
+	count := 0
+	for i := 0; i <= n; i++ {
+		if IsPrime(i) {
+			count++
+		}
+	}
+
+	if len(primes) != count {
+		t.Errorf("PrimesUpTo() returned %d primes, want %d", len(primes), count)
+	}
+}
+
+// TestFactorize tests the Factorize function.
+func TestFactorize(t *testing.T) {
+	tests := []struct {
+		name string
+		v    int64
+		want []int64
+	}{
+		{"Zero", 0, []int64{}},
+		{"One", 1, []int64{}},
+		{"Prime", 97, []int64{97}},
+		{"Composite", 360, []int64{2, 2, 2, 3, 3, 5}},
+		{"Negative", -98, []int64{2, 7, 7}},
+		{"Large", 999999000001 * 3, []int64{3, 999999000001}},
+		{"Semiprime", 1000003 * 1000033, []int64{1000003, 1000033}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := Factorize(tt.v)
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Factorize() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+// TestDivisors tests the Divisors function.
+func TestDivisors(t *testing.T) {
+	tests := []struct {
+		name string
+		v    int
+		want []int
+	}{
+		{"Zero", 0, []int{}},
+		{"One", 1, []int{1}},
+		{"Prime", -7, []int{1, 7}},
+		{"Composite", 12, []int{1, 2, 3, 4, 6, 12}},
+		{"Square", 36, []int{1, 2, 3, 4, 6, 9, 12, 18, 36}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := Divisors(tt.v)
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Divisors() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+
+	// The magnitude of the minimum value doesn't fit into the type.
+	got8 := Divisors[int8](math.MinInt8)
+	want8 := []int8{1, 2, 4, 8, 16, 32, 64}
+	if !reflect.DeepEqual(got8, want8) {
+		t.Errorf("Divisors[int8](MinInt8) = %v, want %v", got8, want8)
+	}
+
+	got64 := Divisors[int64](math.MinInt64)
+	if len(got64) != 63 || got64[0] != 1 || got64[62] != 1<<62 {
+		t.Errorf("Divisors[int64](MinInt64) = %v, want powers of two "+
+			"up to 1<<62", got64)
+	}
+}
@@ -0,0 +1,283 @@
+package g
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"unicode/utf8"
+)
+
+// Base62Alphabet is the default alphabet of the IntToBase and BaseToInt
+// functions. For bases up to 36 it matches the strconv package digits.
+const Base62Alphabet = "0123456789" +
+	"abcdefghijklmnopqrstuvwxyz" +
+	"ABCDEFGHIJKLMNOPQRSTUVWXYZ"
+
+const (
+	// The crockfordAlphabet is the alphabet of Crockford's base32,
+	// without I, L, O and U to avoid confusion and accidental obscenity.
+	crockfordAlphabet = "0123456789ABCDEFGHJKMNPQRSTVWXYZ"
+
+	// The crockfordCheckSymbols are the additional symbols for the check
+	// values 32-36 of Crockford's base32.
+	crockfordCheckSymbols = "*~$=U"
+)
+
+// The baseDigits function returns the digits of the base
+// from the alphabet, or an error if the alphabet cannot be used.
+func baseDigits(base int, alphabet []string) ([]rune, error) {
+	if base < 2 || base > 62 && len(alphabet) == 0 {
+		return nil, fmt.Errorf("invalid base %d", base)
+	}
+
+	digits := []rune(Base62Alphabet)
+	if len(alphabet) > 0 {
+		digits = []rune(alphabet[0])
+	}
+
+	if len(digits) < base {
+		return nil, fmt.Errorf(
+			"alphabet has %d digits, base %d requires %d",
+			len(digits), base, base,
+		)
+	}
+
+	digits = digits[:base]
+	seen := make(map[rune]struct{}, base)
+	for _, r := range digits {
+		if _, ok := seen[r]; ok || r == '-' {
+			return nil, fmt.Errorf("invalid alphabet digit %q", r)
+		}
+		seen[r] = struct{}{}
+	}
+
+	return digits, nil
+}
+
+// The encodeBase function writes the magnitude by the digits.
+func encodeBase(m uint64, digits []rune) string {
+	base := uint64(len(digits))
+	buf := make([]rune, 0, 64)
+	for {
+		buf = append(buf, digits[m%base])
+		m /= base
+		if m == 0 {
+			break
+		}
+	}
+
+	for i, j := 0, len(buf)-1; i < j; i, j = i+1, j-1 {
+		buf[i], buf[j] = buf[j], buf[i]
+	}
+
+	return string(buf)
+}
+
+// The decodeBase function reads a magnitude written by the digits.
+// The value function returns the value of the digit or -1
+// if the rune is not a digit.
+func decodeBase(s string, base int, value func(rune) int) (uint64, error) {
+	if s == "" {
+		return 0, errors.New("empty string")
+	}
+
+	var m uint64
+	limit := ^uint64(0) / uint64(base)
+	for _, r := range s {
+		d := value(r)
+		if d < 0 {
+			return 0, fmt.Errorf("invalid digit %q", r)
+		}
+
+		if m > limit || m*uint64(base) > ^uint64(0)-uint64(d) {
+			return 0, errors.New("uint64 overflow occurred")
+		}
+		m = m*uint64(base) + uint64(d)
+	}
+
+	return m, nil
+}
+
+// The fromMagnitude function converts the magnitude and sign
+// to the value of type T and checks that it fits the type.
+func fromMagnitude[T Integer](m uint64, negative bool) (T, error) {
+	k := kindOf[T]()
+	if negative {
+		if !k.signed {
+			return 0, fmt.Errorf("%T underflow occurred", T(0))
+		}
+
+		if m > k.max+1 {
+			return 0, fmt.Errorf("%T overflow occurred", T(0))
+		}
+
+		return -T(m), nil
+	}
+
+	if m > k.max {
+		return 0, fmt.Errorf("%T overflow occurred", T(0))
+	}
+
+	return T(m), nil
+}
+
+// IntToBase converts an integer to a string in the given base
+// from 2 to 62, e.g. to shorten database IDs for URLs.
+//
+// By default, the digits are taken from the Base62Alphabet, so for bases
+// up to 36 the result is the same as of the strconv.FormatInt function.
+// A custom alphabet can be passed as the optional argument, its first
+// base characters are used as digits; in this case the base can be
+// greater than 62. Negative values are prefixed with "-".
+//
+// Example usage:
+//
+//	g.IntToBase(255, 16)                   // Output: "ff", nil
+//	g.IntToBase(123456789, 62)             // Output: "8m0Kx", nil
+//	g.IntToBase(5, 2, "ab")                // Output: "bab", nil
+//	g.IntToBase(10, 1)                     // Output: "", error
+func IntToBase[T Integer](v T, base int, alphabet ...string) (string, error) {
+	digits, err := baseDigits(base, alphabet)
+	if err != nil {
+		return "", err
+	}
+
+	s := encodeBase(magnitude(v), digits)
+	if v < 0 {
+		return "-" + s, nil
+	}
+
+	return s, nil
+}
+
+// BaseToInt converts a string in the given base from 2 to 62
+// to an integer of type T. It is the inverse of the IntToBase function.
+//
+// With the default alphabet and bases up to 36 the digits
+// are case-insensitive, as in the strconv.ParseInt function.
+// A leading "+" or "-" sign is allowed. The function returns an error
+// if the string contains characters that are not digits of the base,
+// or if the value does not fit into the type T.
+//
+// Example usage:
+//
+//	g.BaseToInt[int]("ff", 16)        // Output: 255, nil
+//	g.BaseToInt[int64]("8m0Kx", 62)   // Output: 123456789, nil
+//	g.BaseToInt[uint8]("100", 16)     // Output: 0, error: uint8 overflow
+//	g.BaseToInt[int]("bab", 2, "ab")  // Output: 5, nil
+func BaseToInt[T Integer](s string, base int, alphabet ...string) (T, error) {
+	digits, err := baseDigits(base, alphabet)
+	if err != nil {
+		return 0, err
+	}
+
+	index := make(map[rune]int, len(digits))
+	for i, r := range digits {
+		index[r] = i
+	}
+
+	foldCase := len(alphabet) == 0 && base <= 36
+	value := func(r rune) int {
+		if foldCase && r >= 'A' && r <= 'Z' {
+			r += 'a' - 'A'
+		}
+
+		if d, ok := index[r]; ok {
+			return d
+		}
+
+		return -1
+	}
+
+	negative := strings.HasPrefix(s, "-")
+	if negative || strings.HasPrefix(s, "+") {
+		s = s[1:]
+	}
+
+	m, err := decodeBase(s, base, value)
+	if err != nil {
+		return 0, err
+	}
+
+	return fromMagnitude[T](m, negative)
+}
+
+// IntToCrockford encodes a non-negative integer in Crockford's base32,
+// a human-friendly encoding without the ambiguous letters I, L, O and U.
+//
+// If the optional checksum argument is true, a check symbol
+// (the value modulo 37) is appended to the result.
+// Negative values cannot be encoded and cause an error.
+//
+// Example usage:
+//
+//	g.IntToCrockford(1234)        // Output: "16J", nil
+//	g.IntToCrockford(1234, true)  // Output: "16JD", nil
+func IntToCrockford[T Integer](v T, checksum ...bool) (string, error) {
+	if v < 0 {
+		return "", fmt.Errorf("negative value %d cannot be encoded", v)
+	}
+
+	m := magnitude(v)
+	s := encodeBase(m, []rune(crockfordAlphabet))
+	if All(checksum...) {
+		s += string((crockfordAlphabet + crockfordCheckSymbols)[m%37])
+	}
+
+	return s, nil
+}
+
+// CrockfordToInt decodes a string in Crockford's base32
+// to an integer of type T. It is the inverse of the IntToCrockford
+// function.
+//
+// Decoding is case-insensitive, hyphens are ignored, and the letters
+// I and L are read as 1 and O as 0. If the optional checksum argument is
+// true, the last symbol is verified as the check symbol. The function
+// returns an error for invalid symbols, wrong check symbols, or if the
+// value does not fit into the type T.
+//
+// Example usage:
+//
+//	g.CrockfordToInt[int]("16j")         // Output: 1234, nil
+//	g.CrockfordToInt[int]("16J-D", true) // Output: 1234, nil
+//	g.CrockfordToInt[int]("16JA", true)  // Output: 0, error
+func CrockfordToInt[T Integer](s string, checksum ...bool) (T, error) {
+	s = strings.ToUpper(strings.ReplaceAll(s, "-", ""))
+	value := func(r rune) int {
+		switch r {
+		case 'O':
+			r = '0'
+		case 'I', 'L':
+			r = '1'
+		}
+
+		return strings.IndexRune(crockfordAlphabet, r)
+	}
+
+	check := -1
+	if All(checksum...) {
+		if s == "" {
+			return 0, errors.New("empty string")
+		}
+
+		last, size := utf8.DecodeLastRuneInString(s)
+		if check = strings.IndexRune(crockfordCheckSymbols, last); check >= 0 {
+			check += len(crockfordAlphabet)
+		} else if check = value(last); check < 0 {
+			return 0, fmt.Errorf("invalid check symbol %q", last)
+		}
+		s = s[:len(s)-size]
+	}
+
+	m, err := decodeBase(s, len(crockfordAlphabet), value)
+	if err != nil {
+		return 0, err
+	}
+
+	if check >= 0 && m%37 != uint64(check) {
+		return 0, errors.New("checksum mismatch")
+	}
+
+	return fromMagnitude[T](m, false)
+}
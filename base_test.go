@@ -0,0 +1,200 @@
+package g
+
+import (
+	"math"
+	"strconv"
+	"testing"
+)
+
+// TestIntToBase tests the IntToBase function.
+func TestIntToBase(t *testing.T) {
+	tests := []struct {
+		name     string
+		value    int64
+		base     int
+		alphabet []string
+		want     string
+	}{
+		{"Zero", 0, 10, nil, "0"},
+		{"Binary", 10, 2, nil, "1010"},
+		{"Hex", 255, 16, nil, "ff"},
+		{"Base36", 35, 36, nil, "z"},
+		{"Base62", 123456789, 62, nil, "8m0Kx"},
+		{"Base62 max digit", 61, 62, nil, "Z"},
+		{"Negative", -255, 16, nil, "-ff"},
+		{"Min int64", math.MinInt64, 16, nil, "-8000000000000000"},
+		{"Custom", 5, 2, []string{"ab"}, "bab"},
+		{"Custom prefix", 5, 3, []string{"xyzw"}, "yz"},
+		{"Custom unicode", 3, 2, []string{"○●"}, "●●"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := IntToBase(tt.value, tt.base, tt.alphabet...)
+			if err != nil || got != tt.want {
+				t.Errorf("IntToBase() = %q, %v, want %q", got, err, tt.want)
+			}
+		})
+	}
+
+	for base := 2; base <= 36; base++ {
+		got, _ := IntToBase(uint64(math.MaxUint64), base)
+		if want := strconv.FormatUint(math.MaxUint64, base); got != want {
+			t.Errorf("IntToBase(MaxUint64, %d) = %q, want %q", base, got, want)
+		}
+	}
+
+	errs := []struct {
+		base     int
+		alphabet []string
+	}{
+		{1, nil},
+		{63, nil},
+		{10, []string{"0123"}},
+		{3, []string{"aab"}},
+		{3, []string{"a-b"}},
+	}
+	for _, e := range errs {
+		if _, err := IntToBase(1, e.base, e.alphabet...); err == nil {
+			t.Errorf("IntToBase(1, %d, %v) expected error", e.base, e.alphabet)
+		}
+	}
+}
+
+// TestBaseToInt tests the BaseToInt function.
+func TestBaseToInt(t *testing.T) {
+	if v, err := BaseToInt[int]("ff", 16); err != nil || v != 255 {
+		t.Errorf("BaseToInt(\"ff\", 16) = %d, %v", v, err)
+	}
+
+	if v, err := BaseToInt[int]("FF", 16); err != nil || v != 255 {
+		t.Errorf("BaseToInt(\"FF\", 16) = %d, %v", v, err)
+	}
+
+	if v, err := BaseToInt[int64]("8m0Kx", 62); err != nil || v != 123456789 {
+		t.Errorf("BaseToInt(\"8m0Kx\", 62) = %d, %v", v, err)
+	}
+
+	if v, err := BaseToInt[int]("bab", 2, "ab"); err != nil || v != 5 {
+		t.Errorf("BaseToInt(\"bab\", 2, \"ab\") = %d, %v", v, err)
+	}
+
+	if v, err := BaseToInt[int8]("-80", 16); err != nil || v != -128 {
+		t.Errorf("BaseToInt[int8](\"-80\", 16) = %d, %v", v, err)
+	}
+
+	if v, err := BaseToInt[int]("Z", 62); err != nil || v != 61 {
+		t.Errorf("BaseToInt(\"Z\", 62) = %d, %v", v, err)
+	}
+
+	if v, err := BaseToInt[int]("+z", 36); err != nil || v != 35 {
+		t.Errorf("BaseToInt(\"+z\", 36) = %d, %v", v, err)
+	}
+
+	invalid := []struct {
+		name string
+		fn   func() error
+	}{
+		{"Empty", func() error { _, err := BaseToInt[int]("", 10); return err }},
+		{"Sign only", func() error { _, err := BaseToInt[int]("-", 10); return err }},
+		{"Bad digit", func() error { _, err := BaseToInt[int]("12a", 10); return err }},
+		{"Short alphabet", func() error {
+			_, err := BaseToInt[int]("1", 62, "0123456789")
+			return err
+		}},
+		{"Int8 overflow", func() error { _, err := BaseToInt[int8]("80", 16); return err }},
+		{"Int8 underflow", func() error { _, err := BaseToInt[int8]("-81", 16); return err }},
+		{"Uint negative", func() error { _, err := BaseToInt[uint]("-1", 10); return err }},
+		{"Uint64 overflow", func() error {
+			_, err := BaseToInt[uint64]("18446744073709551616", 10)
+			return err
+		}},
+	}
+
+	for _, tt := range invalid {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := tt.fn(); err == nil {
+				t.Errorf("BaseToInt() expected error")
+			}
+		})
+	}
+
+	for _, v := range []int64{0, 1, -1, 42, math.MaxInt64, math.MinInt64} {
+		for base := 2; base <= 62; base++ {
+			s, _ := IntToBase(v, base)
+			if got, err := BaseToInt[int64](s, base); err != nil || got != v {
+				t.Fatalf("BaseToInt(%q, %d) = %d, %v, want %d",
+					s, base, got, err, v)
+			}
+		}
+	}
+}
+
+// TestCrockford tests the IntToCrockford and CrockfordToInt functions.
+func TestCrockford(t *testing.T) {
+	if s, err := IntToCrockford(1234); err != nil || s != "16J" {
+		t.Errorf("IntToCrockford(1234) = %q, %v", s, err)
+	}
+
+	if s, err := IntToCrockford(1234, true); err != nil || s != "16JD" {
+		t.Errorf("IntToCrockford(1234, true) = %q, %v", s, err)
+	}
+
+	if s, err := IntToCrockford(36, true); err != nil || s != "14U" {
+		t.Errorf("IntToCrockford(36, true) = %q, %v", s, err)
+	}
+
+	if _, err := IntToCrockford(-1); err == nil {
+		t.Errorf("IntToCrockford(-1) expected error")
+	}
+
+	valid := []struct {
+		input    string
+		checksum bool
+		want     uint64
+	}{
+		{"16J", false, 1234},
+		{"16j", false, 1234},
+		{"16J-D", true, 1234},
+		{"1-6-j-d", true, 1234},
+		{"14U", true, 36},
+		{"oIl", false, 33},
+		{"0", false, 0},
+	}
+	for _, tt := range valid {
+		got, err := CrockfordToInt[uint64](tt.input, tt.checksum)
+		if err != nil || got != tt.want {
+			t.Errorf("CrockfordToInt(%q, %v) = %d, %v, want %d",
+				tt.input, tt.checksum, got, err, tt.want)
+		}
+	}
+
+	invalid := []struct {
+		input    string
+		checksum bool
+	}{
+		{"", false},
+		{"", true},
+		{"U", false},
+		{"16JA", true},
+		{"16J!", true},
+		{"ZZZZZZZZZZZZZZ", false},
+	}
+	for _, tt := range invalid {
+		if _, err := CrockfordToInt[uint64](tt.input, tt.checksum); err == nil {
+			t.Errorf("CrockfordToInt(%q, %v) expected error",
+				tt.input, tt.checksum)
+		}
+	}
+
+	if _, err := CrockfordToInt[uint8]("80"); err == nil {
+		t.Errorf("CrockfordToInt[uint8](\"80\") expected overflow error")
+	}
+
+	for _, v := range []uint64{0, 31, 32, 1 << 40, math.MaxUint64} {
+		s, _ := IntToCrockford(v, true)
+		if got, err := CrockfordToInt[uint64](s, true); err != nil || got != v {
+			t.Errorf("CrockfordToInt(%q) = %d, %v, want %d", s, got, err, v)
+		}
+	}
+}
@@ -3,6 +3,7 @@ package g
 import (
 	"fmt"
 	"math/rand"
+	"strings"
 	"testing"
 	"time"
 )
@@ -189,6 +190,38 @@ func BenchmarkStringOperations(b *testing.B) {
 			_ = Trim(testString, Whitespaces)
 		}
 	})
+
+	// Request bodies: ASCII JSON with line breaks, the same body
+	// without them (nothing to remove) and a body with Cyrillic text.
+	body := strings.Repeat("{\"id\": 12345, \"name\": \"test\"}\r\n\t", 512)
+	clean := Weed(body)
+	unicodeBody := strings.Repeat("{\"назва\": \"Привіт, світ\"}\n", 512)
+	alnum := Preserve(body)
+	padded := " \t\n" + clean + "\r\n "
+
+	benchmarks := []struct {
+		name string
+		fn   func()
+	}{
+		{"Weed/Body", func() { _ = Weed(body) }},
+		{"Weed/BodyUnchanged", func() { _ = Weed(clean) }},
+		{"Weed/BodyUnicode", func() { _ = Weed(unicodeBody) }},
+		{"Weed/BodyUnicodePattern", func() { _ = Weed(unicodeBody, "\n,і") }},
+		{"Preserve/Body", func() { _ = Preserve(body, Numbers) }},
+		{"Preserve/BodyUnchanged", func() { _ = Preserve(alnum) }},
+		{"Preserve/BodyUnicode", func() { _ = Preserve(unicodeBody) }},
+		{"Trim/Body", func() { _ = Trim(padded) }},
+		{"Trim/BodyUnicodePattern", func() { _ = Trim(padded, " \t\r\n«»") }},
+	}
+
+	for _, bm := range benchmarks {
+		b.Run(bm.name, func(b *testing.B) {
+			b.ReportAllocs()
+			for i := 0; i < b.N; i++ {
+				bm.fn()
+			}
+		})
+	}
 }
 
 // Math operation benchmarks.
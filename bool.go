@@ -3,38 +3,245 @@ package g
 import (
 	"errors"
 	"strings"
+	"sync"
+
+	"github.com/goloop/trit"
+)
+
+// BoolVocabulary is a set of words that represent boolean
+// and three-valued logical values in strings.
+//
+// It's used by the StringToBool and StringToTrit functions. The global
+// vocabulary can be replaced with the SetBoolVocabulary function or
+// extended with the ExtendBoolVocabulary function. A vocabulary can
+// also be used directly with its Parse and ParseTrit methods.
+type BoolVocabulary struct {
+	// True is a list of words that mean true, e.g. "yes".
+	True []string
+
+	// False is a list of words that mean false, e.g. "no".
+	False []string
+
+	// Unknown is a list of words that mean trit.Unknown, e.g. "maybe".
+	// These words are accepted by the StringToTrit function only.
+	Unknown []string
+
+	// Strict enables the case-sensitive comparison of words.
+	Strict bool
+}
+
+var (
+	// The defaultBoolVocabulary is the vocabulary used by the StringToBool
+	// and StringToTrit functions by default.
+	defaultBoolVocabulary = BoolVocabulary{
+		True:    []string{"true", "yes", "on"},
+		False:   []string{"false", "no", "off"},
+		Unknown: []string{"unknown", "null", "maybe"},
+	}
+
+	// The boolVocabulary is the current global vocabulary.
+	boolVocabulary = DefaultBoolVocabulary()
+
+	// The boolVocabularyMutex protects the boolVocabulary.
+	boolVocabularyMutex sync.RWMutex
 )
 
+// DefaultBoolVocabulary returns a copy of the vocabulary used by the
+// StringToBool and StringToTrit functions by default: "true", "yes",
+// "on", "false", "no", "off" and, for trits, "unknown", "null" and
+// "maybe". Changes to the copy don't affect the global vocabulary,
+// use the SetBoolVocabulary function to replace it.
+//
+// Example usage:
+//
+//	g.SetBoolVocabulary(g.DefaultBoolVocabulary())  // restore defaults
+func DefaultBoolVocabulary() BoolVocabulary {
+	return defaultBoolVocabulary.With(BoolVocabulary{})
+}
+
+// With returns a new vocabulary that contains the words of both
+// vocabularies. The Strict flag of the result is true if it's set
+// in any of them.
+//
+// Example usage:
+//
+//	v := g.DefaultBoolVocabulary().With(g.BoolVocabulary{
+//	    True:  []string{"1", "y", "t", "enabled", "так"},
+//	    False: []string{"0", "n", "f", "disabled", "ні"},
+//	})
+func (bv BoolVocabulary) With(other BoolVocabulary) BoolVocabulary {
+	merge := func(a, b []string) []string {
+		result := make([]string, 0, len(a)+len(b))
+		result = append(result, a...)
+		return append(result, b...)
+	}
+
+	return BoolVocabulary{
+		True:    merge(bv.True, other.True),
+		False:   merge(bv.False, other.False),
+		Unknown: merge(bv.Unknown, other.Unknown),
+		Strict:  bv.Strict || other.Strict,
+	}
+}
+
+// The lookup method returns the logical value of the word
+// and true if the word is in the vocabulary.
+func (bv BoolVocabulary) lookup(s string) (trit.Trit, bool) {
+	match := func(words []string) bool {
+		for _, w := range words {
+			if w == s || !bv.Strict && strings.EqualFold(w, s) {
+				return true
+			}
+		}
+		return false
+	}
+
+	switch {
+	case match(bv.True):
+		return trit.True, true
+	case match(bv.False):
+		return trit.False, true
+	case match(bv.Unknown):
+		return trit.Unknown, true
+	}
+
+	return trit.Unknown, false
+}
+
+// Parse converts a string to a boolean using the vocabulary.
+// If the conversion fails and a default value is provided, it
+// returns the default value. Otherwise, it returns an error.
+//
+// Example usage:
+//
+//	v := g.BoolVocabulary{True: []string{"Y"}, False: []string{"N"},
+//	    Strict: true}
+//	b, err := v.Parse("Y")  // true, nil
+//	b, err = v.Parse("y")   // false, error
+func (bv BoolVocabulary) Parse(s string, def ...bool) (bool, error) {
+	var d bool = false
+
+	if len(def) > 0 {
+		d = def[0]
+	}
+
+	if s == "" {
+		return d, errors.New("empty string and no default value")
+	}
+
+	t, ok := bv.lookup(s)
+	if !ok || t.IsUnknown() {
+		return d, errors.New("invalid boolean string")
+	}
+
+	return t.IsTrue(), nil
+}
+
+// ParseTrit converts a string to a trit.Trit using the vocabulary.
+// If the conversion fails and a default value is provided, it
+// returns the default value. Otherwise, it returns an error.
+//
+// Example usage:
+//
+//	t, err := g.DefaultBoolVocabulary().ParseTrit("maybe")  // trit.Unknown, nil
+func (bv BoolVocabulary) ParseTrit(s string, def ...trit.Trit) (trit.Trit, error) {
+	var d trit.Trit = trit.Unknown
+
+	if len(def) > 0 {
+		d = def[0]
+	}
+
+	if s == "" {
+		return d, errors.New("empty string and no default value")
+	}
+
+	t, ok := bv.lookup(s)
+	if !ok {
+		return d, errors.New("invalid trit string")
+	}
+
+	return t, nil
+}
+
+// SetBoolVocabulary replaces the global vocabulary used by the
+// StringToBool and StringToTrit functions.
+//
+// To restore the default behavior, pass the result of the
+// DefaultBoolVocabulary function.
+//
+// Example usage:
+//
+//	// Accept only "1" and "0".
+//	g.SetBoolVocabulary(g.BoolVocabulary{
+//	    True:  []string{"1"},
+//	    False: []string{"0"},
+//	})
+func SetBoolVocabulary(bv BoolVocabulary) {
+	boolVocabularyMutex.Lock()
+	defer boolVocabularyMutex.Unlock()
+
+	// Copy the lists so that changes of the caller's
+	// slices do not affect the global vocabulary.
+	boolVocabulary = bv.With(BoolVocabulary{})
+}
+
+// ExtendBoolVocabulary adds words to the global vocabulary used by the
+// StringToBool and StringToTrit functions. If the Strict flag is set
+// in bv, the global vocabulary becomes case-sensitive.
+//
+// Example usage:
+//
+//	g.ExtendBoolVocabulary(g.BoolVocabulary{
+//	    True:  []string{"1", "y", "t", "enabled", "так"},
+//	    False: []string{"0", "n", "f", "disabled", "ні"},
+//	})
+//	b, err := g.StringToBool("Так")  // true, nil
+func ExtendBoolVocabulary(bv BoolVocabulary) {
+	boolVocabularyMutex.Lock()
+	defer boolVocabularyMutex.Unlock()
+
+	boolVocabulary = boolVocabulary.With(bv)
+}
+
+// The currentBoolVocabulary returns the global vocabulary.
+func currentBoolVocabulary() BoolVocabulary {
+	boolVocabularyMutex.RLock()
+	defer boolVocabularyMutex.RUnlock()
+
+	return boolVocabulary
+}
+
 // StringToBool converts a string to a boolean.
 // It handles various string representations of boolean values such as
 // "true", "false", "yes", "no", "on", "off". If the conversion fails
 // and a default value is provided, it returns the default value. Otherwise,
 // it returns an error.
 //
+// The accepted words are defined by the global vocabulary, see the
+// SetBoolVocabulary and ExtendBoolVocabulary functions.
+//
 // Example Usage:
 //
 //	b, err := StringToBool("true") // true, nil
 //	b, err := StringToBool("yes")  // true, nil
 //	b, err := StringToBool("abc", false) // false, error
 func StringToBool(v string, def ...bool) (bool, error) {
-	var d bool = false
-
-	if len(def) > 0 {
-		d = def[0]
-	}
-
-	v = strings.ToLower(v)
+	return currentBoolVocabulary().Parse(v, def...)
+}
 
-	switch v {
-	case "true", "yes", "on":
-		return true, nil
-	case "false", "no", "off":
-		return false, nil
-	case "":
-		return d, errors.New("empty string and no default value")
-	default:
-		return d, errors.New("invalid boolean string")
-	}
+// StringToTrit converts a string to a trit.Trit.
+// In addition to the words accepted by the StringToBool function,
+// it handles "unknown", "null" and "maybe" as trit.Unknown. If the
+// conversion fails and a default value is provided, it returns the
+// default value. Otherwise, it returns an error.
+//
+// Example Usage:
+//
+//	t, err := StringToTrit("yes")    // trit.True, nil
+//	t, err := StringToTrit("null")   // trit.Unknown, nil
+//	t, err := StringToTrit("abc", trit.False) // trit.False, error
+func StringToTrit(v string, def ...trit.Trit) (trit.Trit, error) {
+	return currentBoolVocabulary().ParseTrit(v, def...)
 }
 
 // BoolToString converts a boolean to a string.
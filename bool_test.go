@@ -2,6 +2,8 @@ package g
 
 import (
 	"testing"
+
+	"github.com/goloop/trit"
 )
 
 func TestStringToBool(t *testing.T) {
@@ -54,3 +56,132 @@ func TestBoolToString(t *testing.T) {
 		}
 	}
 }
+
+// TestDefaultBoolVocabulary tests that the DefaultBoolVocabulary
+// function returns an independent copy.
+func TestDefaultBoolVocabulary(t *testing.T) {
+	v := DefaultBoolVocabulary()
+	v.True[0] = "1"
+	v.False = append(v.False, "0")
+
+	d := DefaultBoolVocabulary()
+	if d.True[0] != "true" || len(d.False) != 3 {
+		t.Errorf("DefaultBoolVocabulary() = %+v, changed by the caller", d)
+	}
+
+	if b, err := StringToBool("true"); err != nil || !b {
+		t.Errorf("StringToBool(%q) = %v, %v", "true", b, err)
+	}
+}
+
+func TestStringToBoolVocabulary(t *testing.T) {
+	defer SetBoolVocabulary(DefaultBoolVocabulary())
+
+	if _, err := StringToBool("1"); err == nil {
+		t.Errorf("StringToBool(%q) expected error", "1")
+	}
+
+	ExtendBoolVocabulary(BoolVocabulary{
+		True:  []string{"1", "y", "t", "enabled", "так"},
+		False: []string{"0", "n", "f", "disabled", "ні"},
+	})
+
+	tests := []struct {
+		input    string
+		expected bool
+		err      bool
+	}{
+		{"1", true, false},
+		{"0", false, false},
+		{"Y", true, false},
+		{"enabled", true, false},
+		{"Так", true, false},
+		{"НІ", false, false},
+		{"yes", true, false},
+		{"maybe", false, true},
+	}
+
+	for _, test := range tests {
+		result, err := StringToBool(test.input)
+		if (err != nil) != test.err {
+			t.Errorf("StringToBool(%q) error = %v, wantErr %v",
+				test.input, err, test.err)
+			continue
+		}
+
+		if result != test.expected {
+			t.Errorf("StringToBool(%q) = %v, want %v",
+				test.input, result, test.expected)
+		}
+	}
+
+	SetBoolVocabulary(BoolVocabulary{
+		True:   []string{"Y"},
+		False:  []string{"N"},
+		Strict: true,
+	})
+
+	if result, err := StringToBool("Y"); err != nil || !result {
+		t.Errorf("StringToBool(%q) = %v, %v", "Y", result, err)
+	}
+
+	if _, err := StringToBool("y"); err == nil {
+		t.Errorf("StringToBool(%q) expected error in strict mode", "y")
+	}
+
+	if _, err := StringToBool("yes"); err == nil {
+		t.Errorf("StringToBool(%q) expected error after replace", "yes")
+	}
+}
+
+func TestBoolVocabularyParse(t *testing.T) {
+	words := []string{"on"}
+	v := BoolVocabulary{True: words, False: []string{"off"}}
+	SetBoolVocabulary(v)
+	defer SetBoolVocabulary(DefaultBoolVocabulary())
+
+	// Changes of the caller's slice must not affect the global vocabulary.
+	words[0] = "changed"
+	if result, err := StringToBool("on"); err != nil || !result {
+		t.Errorf("StringToBool(%q) = %v, %v", "on", result, err)
+	}
+
+	if result, err := v.Parse("changed"); err != nil || !result {
+		t.Errorf("Parse(%q) = %v, %v", "changed", result, err)
+	}
+
+	if result, err := v.Parse("", true); err == nil || !result {
+		t.Errorf("Parse(%q) = %v, %v", "", result, err)
+	}
+}
+
+func TestStringToTrit(t *testing.T) {
+	tests := []struct {
+		input    string
+		expected trit.Trit
+		def      trit.Trit
+		err      bool
+	}{
+		{"true", trit.True, trit.Unknown, false},
+		{"No", trit.False, trit.Unknown, false},
+		{"unknown", trit.Unknown, trit.True, false},
+		{"NULL", trit.Unknown, trit.True, false},
+		{"maybe", trit.Unknown, trit.True, false},
+		{"", trit.False, trit.False, true},
+		{"abc", trit.True, trit.True, true},
+	}
+
+	for _, test := range tests {
+		result, err := StringToTrit(test.input, test.def)
+		if (err != nil) != test.err {
+			t.Errorf("StringToTrit(%q) error = %v, wantErr %v",
+				test.input, err, test.err)
+			continue
+		}
+
+		if result != test.expected {
+			t.Errorf("StringToTrit(%q) = %v, want %v",
+				test.input, result, test.expected)
+		}
+	}
+}
@@ -0,0 +1,211 @@
+package g
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+	"sync/atomic"
+)
+
+// ErrorMode defines how the StringsTo function handles values
+// that cannot be converted.
+type ErrorMode int
+
+const (
+	// OnErrorStop stops the conversion at the first invalid value.
+	OnErrorStop ErrorMode = iota
+
+	// OnErrorSkip skips invalid values, the result contains
+	// only the converted values.
+	OnErrorSkip
+
+	// OnErrorDefault substitutes invalid values with the default value,
+	// the result is aligned with the input.
+	OnErrorDefault
+)
+
+// BulkOptions is a set of options for the StringsTo function.
+type BulkOptions[T any] struct {
+	// Mode defines how invalid values are handled.
+	Mode ErrorMode
+
+	// Default is the value substituted for invalid values
+	// in the OnErrorDefault mode.
+	Default T
+
+	// Parallel enables the concurrent conversion of large slices
+	// according to the package's parallel settings.
+	Parallel bool
+}
+
+// IndexError describes an error of converting a single element
+// by the StringsTo function.
+type IndexError struct {
+	Index int    // index of the element in the input
+	Input string // value that could not be converted
+	Err   error  // reason of the failure
+}
+
+// Error returns the description of the element error.
+func (e *IndexError) Error() string {
+	return fmt.Sprintf("index %d (%q): %v", e.Index, e.Input, e.Err)
+}
+
+// Unwrap returns the reason of the element error.
+func (e *IndexError) Unwrap() error {
+	return e.Err
+}
+
+// BulkError is a report of all element errors that occurred
+// during conversion by the StringsTo function.
+type BulkError struct {
+	Errors []*IndexError
+}
+
+// Error returns the description of all element errors.
+func (e *BulkError) Error() string {
+	msgs := make([]string, len(e.Errors))
+	for i, err := range e.Errors {
+		msgs[i] = err.Error()
+	}
+
+	return fmt.Sprintf(
+		"failed to convert %d value(s): %s",
+		len(e.Errors),
+		strings.Join(msgs, "; "),
+	)
+}
+
+// Unwrap returns all element errors, so that the errors.Is and
+// errors.As functions can inspect them.
+func (e *BulkError) Unwrap() []error {
+	result := make([]error, len(e.Errors))
+	for i, err := range e.Errors {
+		result[i] = err
+	}
+
+	return result
+}
+
+// StringsTo converts a slice of strings to a slice of values of type T,
+// e.g. a column of a CSV file. Each value is converted as the StringTo
+// function does.
+//
+// The mode from the options defines how invalid values are handled:
+//   - OnErrorStop (by default): the function returns nil and an error
+//     for the first invalid value; in parallel mode, all chunks stop
+//     converting values that follow an invalid one;
+//   - OnErrorSkip: invalid values are omitted from the result;
+//   - OnErrorDefault: invalid values are replaced with the default
+//     value from the options.
+//
+// In the OnErrorSkip and OnErrorDefault modes, the function returns the
+// result together with a *BulkError that lists the index, the input and
+// the reason for every invalid value. If there are no invalid values,
+// the error is nil.
+//
+// Example usage:
+//
+//	ints, err := g.StringsTo[int]([]string{"1", "2", "3"})
+//	// Output: [1 2 3], nil
+//
+//	ints, err = g.StringsTo([]string{"1", "x", "3"}, g.BulkOptions[int]{
+//	    Mode: g.OnErrorDefault,
+//	    Default: -1,
+//	})
+//	// Output: [1 -1 3], failed to convert 1 value(s): index 1 ("x"): ...
+func StringsTo[T Numerable | ~bool | ~string](
+	ss []string,
+	opts ...BulkOptions[T],
+) ([]T, error) {
+	var opt BulkOptions[T]
+	if len(opts) > 0 {
+		opt = opts[0]
+	}
+
+	// In the OnErrorStop mode, stop holds the lowest index of an invalid
+	// value found so far by any chunk. Chunks don't convert values past
+	// it, but still check the values before it, so the first invalid
+	// value by index is always found.
+	var stop atomic.Int64
+	stop.Store(int64(len(ss)))
+	stopAt := func(i int) {
+		for {
+			cur := stop.Load()
+			if int64(i) >= cur || stop.CompareAndSwap(cur, int64(i)) {
+				return
+			}
+		}
+	}
+
+	values := make([]T, len(ss))
+	valid := make([]bool, len(ss))
+	convert := func(start, end int) []*IndexError {
+		var errs []*IndexError
+		for i := start; i < end; i++ {
+			if opt.Mode == OnErrorStop && int64(i) > stop.Load() {
+				break
+			}
+
+			v, err := StringTo[T](ss[i])
+			if err != nil {
+				errs = append(errs, &IndexError{Index: i, Input: ss[i], Err: err})
+				if opt.Mode == OnErrorStop {
+					stopAt(i)
+					break
+				}
+				continue
+			}
+
+			values[i], valid[i] = v, true
+		}
+
+		return errs
+	}
+
+	// Chunks can finish in any order, so errors are sorted by index.
+	var (
+		errs []*IndexError
+		mu   sync.Mutex
+	)
+	run := func(start, end int) {
+		chunkErrs := convert(start, end)
+		mu.Lock()
+		defer mu.Unlock()
+		errs = append(errs, chunkErrs...)
+	}
+
+	if opt.Parallel {
+		doInChunks(len(ss), run)
+	} else {
+		run(0, len(ss))
+	}
+	sort.Slice(errs, func(i, j int) bool {
+		return errs[i].Index < errs[j].Index
+	})
+
+	if len(errs) == 0 {
+		return values, nil
+	}
+
+	switch opt.Mode {
+	case OnErrorSkip:
+		result := make([]T, 0, len(ss)-len(errs))
+		for i, v := range values {
+			if valid[i] {
+				result = append(result, v)
+			}
+		}
+		return result, &BulkError{Errors: errs}
+	case OnErrorDefault:
+		for i := range values {
+			if !valid[i] {
+				values[i] = opt.Default
+			}
+		}
+		return values, &BulkError{Errors: errs}
+	}
+
+	return nil, &BulkError{Errors: errs[:1]}
+}
@@ -0,0 +1,153 @@
+package g
+
+import (
+	"errors"
+	"reflect"
+	"strconv"
+	"testing"
+)
+
+// TestStringsTo tests the StringsTo function.
+func TestStringsTo(t *testing.T) {
+	ss := []string{"1", "x", "3", "300", "5"}
+
+	got, err := StringsTo[int]([]string{"1", "0x2", "3"})
+	if err != nil || !reflect.DeepEqual(got, []int{1, 2, 3}) {
+		t.Errorf("StringsTo() = %v, %v", got, err)
+	}
+
+	// Stop at the first error.
+	got8, err := StringsTo[int8](ss)
+	var report *BulkError
+	if got8 != nil || !errors.As(err, &report) || len(report.Errors) != 1 ||
+		report.Errors[0].Index != 1 || report.Errors[0].Input != "x" {
+		t.Errorf("StringsTo() = %v, %v", got8, err)
+	}
+
+	// Skip invalid values.
+	got8, err = StringsTo(ss, BulkOptions[int8]{Mode: OnErrorSkip})
+	if !reflect.DeepEqual(got8, []int8{1, 3, 5}) || !errors.As(err, &report) {
+		t.Fatalf("StringsTo() = %v, %v", got8, err)
+	}
+
+	var indexes []int
+	for _, e := range report.Errors {
+		indexes = append(indexes, e.Index)
+	}
+	if !reflect.DeepEqual(indexes, []int{1, 3}) {
+		t.Errorf("StringsTo() error indexes = %v, want [1 3]", indexes)
+	}
+
+	if !errors.Is(err, strconv.ErrRange) {
+		t.Errorf("StringsTo() error should wrap strconv.ErrRange: %v", err)
+	}
+
+	// Substitute the default value.
+	got8, err = StringsTo(ss, BulkOptions[int8]{
+		Mode:    OnErrorDefault,
+		Default: -1,
+	})
+	if !reflect.DeepEqual(got8, []int8{1, -1, 3, -1, 5}) || err == nil {
+		t.Errorf("StringsTo() = %v, %v", got8, err)
+	}
+
+	// Other types.
+	bools, err := StringsTo[bool]([]string{"yes", "off"})
+	if err != nil || !reflect.DeepEqual(bools, []bool{true, false}) {
+		t.Errorf("StringsTo() = %v, %v", bools, err)
+	}
+
+	empty, err := StringsTo[float64](nil)
+	if err != nil || len(empty) != 0 {
+		t.Errorf("StringsTo() = %v, %v", empty, err)
+	}
+}
+
+// TestStringsToParallel tests the StringsTo function with data
+// large enough to trigger parallel execution.
+func TestStringsToParallel(t *testing.T) {
+	size := minLoadPerGoroutine*parallelTasks + 11
+	ss := make([]string, size)
+	for i := range ss {
+		ss[i] = strconv.Itoa(i)
+	}
+
+	bad := []int{size - 1, 7, size / 2}
+	for _, i := range bad {
+		ss[i] = "bad"
+	}
+
+	got, err := StringsTo(ss, BulkOptions[int]{
+		Mode:     OnErrorDefault,
+		Default:  -1,
+		Parallel: true,
+	})
+
+	var report *BulkError
+	if !errors.As(err, &report) || len(report.Errors) != 3 {
+		t.Fatalf("StringsTo() error = %v", err)
+	}
+
+	if report.Errors[0].Index != 7 || report.Errors[1].Index != size/2 ||
+		report.Errors[2].Index != size-1 {
+		t.Errorf("StringsTo() errors are not sorted by index")
+	}
+
+	for i, v := range got {
+		want := i
+		if In(i, bad...) {
+			want = -1
+		}
+
+		if v != want {
+			t.Fatalf("StringsTo()[%d] = %d, want %d", i, v, want)
+		}
+	}
+
+	_, err = StringsTo(ss, BulkOptions[int]{Parallel: true})
+	if !errors.As(err, &report) || report.Errors[0].Index != 7 {
+		t.Errorf("StringsTo() error = %v, want the first invalid index", err)
+	}
+}
+
+// TestStringsToParallelStop tests that in the OnErrorStop mode
+// the first invalid value by index is reported when several chunks
+// contain invalid values.
+func TestStringsToParallelStop(t *testing.T) {
+	defer ParallelTasks(ParallelTasks())
+	ParallelTasks(4)
+
+	size := minLoadPerGoroutine*4 + 3
+	ss := make([]string, size)
+	for i := range ss {
+		ss[i] = strconv.Itoa(i)
+	}
+
+	tests := []struct {
+		name string
+		bad  []int
+		want int
+	}{
+		{"First chunk", []int{5, size / 2, size - 1}, 5},
+		{"Last chunk", []int{size - 1}, size - 1},
+		{"Same chunk", []int{size/4 + 9, size/4 + 1}, size/4 + 1},
+		{"Later chunk first", []int{size / 3, size - 2}, size / 3},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			data := append([]string(nil), ss...)
+			for _, i := range tt.bad {
+				data[i] = "bad"
+			}
+
+			got, err := StringsTo(data, BulkOptions[int]{Parallel: true})
+			var report *BulkError
+			if got != nil || !errors.As(err, &report) ||
+				len(report.Errors) != 1 || report.Errors[0].Index != tt.want {
+				t.Errorf("StringsTo() = %v, %v, want the index %d",
+					got, err, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,162 @@
+package g
+
+import (
+	"fmt"
+	"math"
+	"math/bits"
+	"strconv"
+	"strings"
+)
+
+// ByteUnits is a system of units for byte sizes
+// used by the FormatBytes function.
+type ByteUnits int
+
+const (
+	// SI is the decimal system of units: 1 kB = 1000 B.
+	SI ByteUnits = iota
+
+	// IEC is the binary system of units: 1 KiB = 1024 B.
+	IEC
+)
+
+var (
+	// The siByteUnits is a list of SI units for the FormatBytes function.
+	siByteUnits = []string{"B", "kB", "MB", "GB", "TB", "PB", "EB"}
+
+	// The iecByteUnits is a list of IEC units for the FormatBytes function.
+	iecByteUnits = []string{"B", "KiB", "MiB", "GiB", "TiB", "PiB", "EiB"}
+
+	// The byteMultipliers is a map of lowercase unit prefixes
+	// to their powers for the ParseBytes function. Units without
+	// the "i" are decimal (SI), units with the "i" are binary (IEC).
+	byteMultipliers = map[string]int{
+		"k": 1, "m": 2, "g": 3, "t": 4, "p": 5, "e": 6,
+	}
+)
+
+// ParseBytes converts a human-readable byte size to a number of bytes.
+//
+// The size is a number, optionally fractional, followed by an optional
+// unit. Units are case-insensitive: SI units (k, kB, M, MB, ... EB) are
+// multiples of 1000, IEC units (Ki, KiB, Mi, MiB, ... EiB) are multiples
+// of 1024, and B or no unit means bytes. Spaces between the number and
+// the unit are allowed. The number is parsed with the StringTo function,
+// so underscores are accepted as separators.
+//
+// The fractional part of the result is truncated. The function returns
+// an error if the size is negative, the unit is unknown or the result
+// does not fit into the type T.
+//
+// Example usage:
+//
+//	n, err := g.ParseBytes[int64]("512MB")   // 512000000, nil
+//	n, err = g.ParseBytes[int64]("1.5GiB")   // 1610612736, nil
+//	n, err = g.ParseBytes[int]("10k")        // 10000, nil
+//	n, err = g.ParseBytes[uint8]("1KiB")     // 0, error: uint8 overflow
+func ParseBytes[T Integer](s string) (T, error) {
+	v := strings.TrimSpace(s)
+	i := strings.IndexFunc(v, func(r rune) bool {
+		return (r < '0' || r > '9') && r != '.' && r != '_' &&
+			r != '+' && r != '-'
+	})
+
+	number, unit := v, ""
+	if i >= 0 {
+		number = strings.TrimSpace(v[:i])
+		unit = strings.ToLower(strings.TrimSpace(v[i:]))
+	}
+
+	// Determine the multiplier by the unit.
+	multiplier := uint64(1)
+	if unit != "" && unit != "b" {
+		unit = strings.TrimSuffix(unit, "b")
+		base, prefix := uint64(1000), unit
+		if strings.HasSuffix(unit, "i") {
+			base, prefix = 1024, strings.TrimSuffix(unit, "i")
+		}
+
+		power, ok := byteMultipliers[prefix]
+		if !ok {
+			return 0, fmt.Errorf("unknown unit in byte size %q", s)
+		}
+
+		for ; power > 0; power-- {
+			multiplier *= base
+		}
+	}
+
+	// Integers are multiplied exactly, fractions via float64.
+	var size uint64
+	if !strings.Contains(number, ".") {
+		n, err := StringTo[uint64](number)
+		if err != nil {
+			return 0, fmt.Errorf("invalid byte size %q: %w", s, err)
+		}
+
+		hi, lo := bits.Mul64(n, multiplier)
+		if hi != 0 {
+			return 0, fmt.Errorf("%T overflow occurred", T(0))
+		}
+		size = lo
+	} else {
+		f, err := StringTo[float64](number)
+		if err != nil {
+			return 0, fmt.Errorf("invalid byte size %q: %w", s, err)
+		} else if f < 0 {
+			return 0, fmt.Errorf("negative byte size %q", s)
+		}
+
+		f = math.Trunc(f * float64(multiplier))
+		if f >= math.Ldexp(1, 64) {
+			return 0, fmt.Errorf("%T overflow occurred", T(0))
+		}
+		size = uint64(f)
+	}
+
+	return Convert[T](size)
+}
+
+// FormatBytes converts a number of bytes to a human-readable string
+// using the largest unit for which the value is at least 1.
+//
+// The units argument selects SI (kB, MB, ... multiples of 1000) or
+// IEC (KiB, MiB, ... multiples of 1024) units. The precision is the
+// number of digits after the decimal point, if it's negative the
+// shortest representation is used. Sizes less than one kilobyte are
+// always written as integers.
+//
+// Example usage:
+//
+//	g.FormatBytes(1536, g.IEC, 1)       // Output: "1.5 KiB"
+//	g.FormatBytes(1536, g.SI, 2)        // Output: "1.54 kB"
+//	g.FormatBytes(512, g.SI, 2)         // Output: "512 B"
+//	g.FormatBytes(int64(-2e9), g.SI, 0) // Output: "-2 GB"
+func FormatBytes[T Integer](v T, units ByteUnits, precision int) string {
+	names, base := siByteUnits, 1000.0
+	if units == IEC {
+		names, base = iecByteUnits, 1024.0
+	}
+
+	sign := If(v < 0, "-", "")
+	m := magnitude(v)
+	if float64(m) < base {
+		return sign + strconv.FormatUint(m, 10) + " B"
+	}
+
+	value, k := float64(m), 0
+	for value >= base && k < len(names)-1 {
+		value /= base
+		k++
+	}
+
+	// Rounding can produce the base value (1000.0 kB), move to
+	// the next unit in this case.
+	s := strconv.FormatFloat(value, 'f', precision, 64)
+	if f, _ := strconv.ParseFloat(s, 64); f >= base && k < len(names)-1 {
+		k++
+		s = strconv.FormatFloat(value/base, 'f', precision, 64)
+	}
+
+	return sign + s + " " + names[k]
+}
@@ -0,0 +1,88 @@
+package g
+
+import (
+	"math"
+	"testing"
+)
+
+// TestParseBytes tests the ParseBytes function.
+func TestParseBytes(t *testing.T) {
+	tests := []struct {
+		input   string
+		want    int64
+		wantErr bool
+	}{
+		{"0", 0, false},
+		{"100", 100, false},
+		{"100B", 100, false},
+		{"10k", 10000, false},
+		{"10 KB", 10000, false},
+		{"512MB", 512000000, false},
+		{"512mb", 512000000, false},
+		{"1KiB", 1024, false},
+		{"1ki", 1024, false},
+		{"1.5GiB", 1610612736, false},
+		{"1.5 gb", 1500000000, false},
+		{"0.5B", 0, false},
+		{"1_000 kB", 1000000, false},
+		{"8EiB", 0, true},
+		{"-1MB", 0, true},
+		{"-1.5MB", 0, true},
+		{"10 XB", 0, true},
+		{"MB", 0, true},
+		{"1.2.3MB", 0, true},
+	}
+
+	for _, tt := range tests {
+		got, err := ParseBytes[int64](tt.input)
+		if (err != nil) != tt.wantErr {
+			t.Errorf("ParseBytes(%q) error = %v, wantErr %v",
+				tt.input, err, tt.wantErr)
+			continue
+		}
+
+		if got != tt.want {
+			t.Errorf("ParseBytes(%q) = %d, want %d", tt.input, got, tt.want)
+		}
+	}
+
+	if got, err := ParseBytes[uint64]("15EiB"); err != nil ||
+		got != 15<<60 {
+		t.Errorf("ParseBytes[uint64](\"15EiB\") = %v, %v", got, err)
+	}
+
+	if _, err := ParseBytes[uint64]("16EiB"); err == nil {
+		t.Errorf("ParseBytes[uint64](\"16EiB\") expected overflow")
+	}
+
+	if _, err := ParseBytes[uint8]("1KiB"); err == nil {
+		t.Errorf("ParseBytes[uint8](\"1KiB\") expected overflow")
+	}
+}
+
+// TestFormatBytes tests the FormatBytes function.
+func TestFormatBytes(t *testing.T) {
+	tests := []struct {
+		name string
+		got  string
+		want string
+	}{
+		{"Bytes", FormatBytes(512, SI, 2), "512 B"},
+		{"Zero", FormatBytes(0, IEC, 2), "0 B"},
+		{"IEC", FormatBytes(1536, IEC, 1), "1.5 KiB"},
+		{"SI", FormatBytes(1536, SI, 2), "1.54 kB"},
+		{"Negative", FormatBytes(int64(-2e9), SI, 0), "-2 GB"},
+		{"Shortest", FormatBytes(1610612736, IEC, -1), "1.5 GiB"},
+		{"Rounding", FormatBytes(999999, SI, 1), "1.0 MB"},
+		{"Max", FormatBytes(uint64(math.MaxUint64), IEC, 1), "16.0 EiB"},
+		{"MinInt64", FormatBytes(int64(math.MinInt64), IEC, 0), "-8 EiB"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if tt.got != tt.want {
+				t.Errorf("FormatBytes() = %q, want %q", tt.got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,169 @@
+package g
+
+import (
+	"fmt"
+	"unicode"
+)
+
+// The emojiTable contains the code points of emoji, including
+// the regional indicators of flags, skin tone modifiers and
+// the components of emoji sequences (zero width joiner, variation
+// selector, combining keycap and tags).
+var emojiTable = &unicode.RangeTable{
+	R16: []unicode.Range16{
+		{Lo: 0x00a9, Hi: 0x00a9, Stride: 1}, // ©
+		{Lo: 0x00ae, Hi: 0x00ae, Stride: 1}, // ®
+		{Lo: 0x200d, Hi: 0x200d, Stride: 1}, // zero width joiner
+		{Lo: 0x203c, Hi: 0x203c, Stride: 1}, // ‼
+		{Lo: 0x2049, Hi: 0x2049, Stride: 1}, // ⁉
+		{Lo: 0x20e3, Hi: 0x20e3, Stride: 1}, // combining keycap
+		{Lo: 0x2122, Hi: 0x2122, Stride: 1}, // ™
+		{Lo: 0x2139, Hi: 0x2139, Stride: 1}, // ℹ
+		{Lo: 0x2194, Hi: 0x2199, Stride: 1}, // arrows
+		{Lo: 0x21a9, Hi: 0x21aa, Stride: 1}, // arrows with hook
+		{Lo: 0x231a, Hi: 0x231b, Stride: 1}, // watch, hourglass
+		{Lo: 0x2328, Hi: 0x2328, Stride: 1}, // keyboard
+		{Lo: 0x23cf, Hi: 0x23cf, Stride: 1}, // eject
+		{Lo: 0x23e9, Hi: 0x23f3, Stride: 1}, // media controls, clocks
+		{Lo: 0x23f8, Hi: 0x23fa, Stride: 1}, // media controls
+		{Lo: 0x24c2, Hi: 0x24c2, Stride: 1}, // Ⓜ
+		{Lo: 0x25aa, Hi: 0x25ab, Stride: 1}, // small squares
+		{Lo: 0x25b6, Hi: 0x25b6, Stride: 1}, // ▶
+		{Lo: 0x25c0, Hi: 0x25c0, Stride: 1}, // ◀
+		{Lo: 0x25fb, Hi: 0x25fe, Stride: 1}, // medium squares
+		{Lo: 0x2600, Hi: 0x27bf, Stride: 1}, // miscellaneous symbols, dingbats
+		{Lo: 0x2934, Hi: 0x2935, Stride: 1}, // curved arrows
+		{Lo: 0x2b05, Hi: 0x2b07, Stride: 1}, // arrows
+		{Lo: 0x2b1b, Hi: 0x2b1c, Stride: 1}, // large squares
+		{Lo: 0x2b50, Hi: 0x2b50, Stride: 1}, // ⭐
+		{Lo: 0x2b55, Hi: 0x2b55, Stride: 1}, // ⭕
+		{Lo: 0x3030, Hi: 0x3030, Stride: 1}, // 〰
+		{Lo: 0x303d, Hi: 0x303d, Stride: 1}, // 〽
+		{Lo: 0x3297, Hi: 0x3297, Stride: 1}, // ㊗
+		{Lo: 0x3299, Hi: 0x3299, Stride: 1}, // ㊙
+		{Lo: 0xfe0f, Hi: 0xfe0f, Stride: 1}, // variation selector-16
+	},
+	R32: []unicode.Range32{
+		{Lo: 0x1f000, Hi: 0x1f0ff, Stride: 1}, // mahjong, domino, cards
+		{Lo: 0x1f170, Hi: 0x1f171, Stride: 1}, // 🅰 🅱
+		{Lo: 0x1f17e, Hi: 0x1f17f, Stride: 1}, // 🅾 🅿
+		{Lo: 0x1f18e, Hi: 0x1f18e, Stride: 1}, // 🆎
+		{Lo: 0x1f191, Hi: 0x1f19a, Stride: 1}, // squared words
+		{Lo: 0x1f1e6, Hi: 0x1f1ff, Stride: 1}, // regional indicators
+		{Lo: 0x1f201, Hi: 0x1f202, Stride: 1}, // squared katakana
+		{Lo: 0x1f21a, Hi: 0x1f21a, Stride: 1}, // 🈚
+		{Lo: 0x1f22f, Hi: 0x1f22f, Stride: 1}, // 🈯
+		{Lo: 0x1f232, Hi: 0x1f23a, Stride: 1}, // squared ideographs
+		{Lo: 0x1f250, Hi: 0x1f251, Stride: 1}, // circled ideographs
+		{Lo: 0x1f300, Hi: 0x1f64f, Stride: 1}, // pictographs, emoticons
+		{Lo: 0x1f680, Hi: 0x1f6ff, Stride: 1}, // transport and map
+		{Lo: 0x1f7e0, Hi: 0x1f7eb, Stride: 1}, // colored circles, squares
+		{Lo: 0x1f7f0, Hi: 0x1f7f0, Stride: 1}, // heavy equals sign
+		{Lo: 0x1f900, Hi: 0x1f9ff, Stride: 1}, // supplemental pictographs
+		{Lo: 0x1fa70, Hi: 0x1faff, Stride: 1}, // pictographs extended-A
+		{Lo: 0xe0020, Hi: 0xe007f, Stride: 1}, // tags
+	},
+}
+
+// UnicodeLetters reports whether the rune is a letter of any script,
+// e.g. Latin, Cyrillic or CJK. It can be used as a pattern for the
+// WeedFunc, PreserveFunc and TrimFunc functions.
+//
+// Example usage:
+//
+//	g.PreserveFunc("Привіт, світ!", g.UnicodeLetters, " ")
+//	// Output: "Привіт світ"
+func UnicodeLetters(r rune) bool {
+	return unicode.IsLetter(r)
+}
+
+// UnicodeDigits reports whether the rune is a decimal digit of any
+// script, e.g. "٣" or "３". It can be used as a pattern for the
+// WeedFunc, PreserveFunc and TrimFunc functions.
+//
+// Example usage:
+//
+//	g.PreserveFunc("Tel: ٠١٢٣", g.UnicodeDigits)  // Output: "٠١٢٣"
+func UnicodeDigits(r rune) bool {
+	return unicode.IsDigit(r)
+}
+
+// UnicodeSpace reports whether the rune is a white space as defined
+// by Unicode, including line breaks and the non-breaking space.
+// It can be used as a pattern for the WeedFunc, PreserveFunc and
+// TrimFunc functions.
+//
+// Example usage:
+//
+//	g.TrimFunc("\u00a0 text\n", g.UnicodeSpace)  // Output: "text"
+func UnicodeSpace(r rune) bool {
+	return unicode.IsSpace(r)
+}
+
+// Punctuation reports whether the rune is a punctuation mark of any
+// script, e.g. "!", "«" or "。". It can be used as a pattern for the
+// WeedFunc, PreserveFunc and TrimFunc functions.
+//
+// Example usage:
+//
+//	g.WeedFunc("«Привіт», світе!", g.Punctuation)  // Output: "Привіт світе"
+func Punctuation(r rune) bool {
+	return unicode.IsPunct(r)
+}
+
+// Emoji reports whether the rune is an emoji or a component of an emoji
+// sequence, such as the zero width joiner, a skin tone modifier or
+// a regional indicator of a flag. It can be used as a pattern for the
+// WeedFunc, PreserveFunc and TrimFunc functions.
+//
+// Example usage:
+//
+//	g.WeedFunc("Go 🚀🇺🇦", g.Emoji)  // Output: "Go "
+func Emoji(r rune) bool {
+	return unicode.Is(emojiTable, r)
+}
+
+// The runeMatcher function combines the patterns into a single predicate
+// that reports whether the rune matches at least one of them.
+//
+// The pattern can be a string (a list of characters), a rune,
+// a func(rune) bool predicate, a *unicode.RangeTable or a *CharSet.
+// It panics if the pattern has another type, as it's a programming
+// error that cannot be handled at runtime.
+func runeMatcher(patterns []any) func(rune) bool {
+	chars := make(map[rune]struct{})
+	var funcs []func(rune) bool
+	var tables []*unicode.RangeTable
+	for _, p := range patterns {
+		switch v := p.(type) {
+		case string:
+			for _, r := range v {
+				chars[r] = struct{}{}
+			}
+		case rune:
+			chars[v] = struct{}{}
+		case func(rune) bool:
+			funcs = append(funcs, v)
+		case *unicode.RangeTable:
+			tables = append(tables, v)
+		case *CharSet:
+			funcs = append(funcs, v.Contains)
+		default:
+			panic(fmt.Sprintf("g: unsupported pattern type %T", p))
+		}
+	}
+
+	return func(r rune) bool {
+		if _, ok := chars[r]; ok {
+			return true
+		}
+
+		for _, f := range funcs {
+			if f(r) {
+				return true
+			}
+		}
+
+		return len(tables) != 0 && unicode.IsOneOf(tables, r)
+	}
+}
@@ -0,0 +1,36 @@
+package g
+
+import "testing"
+
+// TestCharClasses tests the UnicodeLetters, UnicodeDigits, UnicodeSpace,
+// Punctuation and Emoji functions.
+func TestCharClasses(t *testing.T) {
+	tests := []struct {
+		name  string
+		class func(rune) bool
+		yes   string
+		no    string
+	}{
+		{"UnicodeLetters", UnicodeLetters, "aZїЖ漢α", "1 !_🚀"},
+		{"UnicodeDigits", UnicodeDigits, "09٣３", "aⅫ ½"},
+		{"UnicodeSpace", UnicodeSpace, " \t\n\u00a0\u2003", "a_\u200b"},
+		{"Punctuation", Punctuation, "!,.«»。—", "a1 +$"},
+		{"Emoji", Emoji, "😀🚀🇺🇦👍🏽☀⭐🦀🪐\u200d\ufe0f", "aї1 !€"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			for _, r := range tt.yes {
+				if !tt.class(r) {
+					t.Errorf("%s(%q) = false, want true", tt.name, r)
+				}
+			}
+
+			for _, r := range tt.no {
+				if tt.class(r) {
+					t.Errorf("%s(%q) = true, want false", tt.name, r)
+				}
+			}
+		})
+	}
+}
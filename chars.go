@@ -0,0 +1,308 @@
+package g
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+// The runeRange is an inclusive range of runes.
+type runeRange struct {
+	lo, hi rune
+}
+
+// The posixClasses are the ASCII character classes that can be used
+// in the Chars patterns as [:name:], they match the classes of the
+// regexp package.
+var posixClasses = map[string][]runeRange{
+	"alnum":  {{'0', '9'}, {'A', 'Z'}, {'a', 'z'}},
+	"alpha":  {{'A', 'Z'}, {'a', 'z'}},
+	"ascii":  {{0x00, 0x7f}},
+	"blank":  {{'\t', '\t'}, {' ', ' '}},
+	"cntrl":  {{0x00, 0x1f}, {0x7f, 0x7f}},
+	"digit":  {{'0', '9'}},
+	"graph":  {{'!', '~'}},
+	"lower":  {{'a', 'z'}},
+	"print":  {{' ', '~'}},
+	"punct":  {{'!', '/'}, {':', '@'}, {'[', '`'}, {'{', '~'}},
+	"space":  {{'\t', '\r'}, {' ', ' '}},
+	"upper":  {{'A', 'Z'}},
+	"word":   {{'0', '9'}, {'A', 'Z'}, {'_', '_'}, {'a', 'z'}},
+	"xdigit": {{'0', '9'}, {'A', 'F'}, {'a', 'f'}},
+}
+
+// CharSet is a compiled set of characters, created by the Chars
+// or ParseChars functions. It's safe for concurrent use and
+// should be compiled once and reused.
+//
+// A CharSet is applied to strings by its Weed, Preserve and Trim
+// methods, the counterparts of the Weed, Preserve and Trim functions.
+// It can also be used as a pattern for the WeedFunc, PreserveFunc
+// and TrimFunc functions, and mixed with other patterns there.
+type CharSet struct {
+	spec   string
+	negate bool
+	ascii  [2]uint64 // bitset of the ASCII characters
+	ranges []runeRange
+	tables []*unicode.RangeTable
+}
+
+// Contains reports whether the rune is in the set.
+func (cs *CharSet) Contains(r rune) bool {
+	var ok bool
+	if r >= 0 && r < utf8.RuneSelf {
+		ok = cs.ascii[r>>6]&(1<<(r&63)) != 0
+	} else {
+		i := sort.Search(len(cs.ranges), func(i int) bool {
+			return cs.ranges[i].hi >= r
+		})
+		ok = i < len(cs.ranges) && cs.ranges[i].lo <= r ||
+			len(cs.tables) != 0 && unicode.IsOneOf(cs.tables, r)
+	}
+
+	return ok != cs.negate
+}
+
+// String returns the source pattern of the set.
+func (cs *CharSet) String() string {
+	return cs.spec
+}
+
+// Weed removes characters of the set from the whole string,
+// as the Weed function does with plain patterns.
+//
+// Example usage:
+//
+//	g.Chars("^a-z").Weed("user name@42!")  // Output: "username"
+func (cs *CharSet) Weed(s string) string {
+	return strings.Map(func(r rune) rune {
+		return If(cs.Contains(r), -1, r)
+	}, s)
+}
+
+// Preserve keeps only characters of the set in the string,
+// as the Preserve function does with plain patterns.
+//
+// Example usage:
+//
+//	g.Chars("a-zA-Z0-9_-").Preserve("user_name@42!")  // Output: "user_name42"
+func (cs *CharSet) Preserve(s string) string {
+	return strings.Map(func(r rune) rune {
+		return If(cs.Contains(r), r, -1)
+	}, s)
+}
+
+// Trim removes all leading and trailing characters of the set,
+// as the Trim function does with plain patterns.
+//
+// Example usage:
+//
+//	g.Chars("[:space:][:punct:]").Trim(" (42). ")  // Output: "42"
+func (cs *CharSet) Trim(s string) string {
+	return strings.TrimFunc(s, cs.Contains)
+}
+
+// The parseCharsRune function reads a single, possibly escaped,
+// character of the pattern at the position i and returns it
+// with the number of runes consumed.
+func parseCharsRune(rs []rune, i int) (rune, int, error) {
+	if rs[i] != '\\' {
+		return rs[i], 1, nil
+	}
+
+	if i+1 >= len(rs) {
+		return 0, 0, errors.New("trailing backslash")
+	}
+
+	switch rs[i+1] {
+	case 'n':
+		return '\n', 2, nil
+	case 'r':
+		return '\r', 2, nil
+	case 't':
+		return '\t', 2, nil
+	case 'v':
+		return '\v', 2, nil
+	case 'f':
+		return '\f', 2, nil
+	case 'b':
+		return '\b', 2, nil
+	case 'u':
+		if i+6 > len(rs) {
+			return 0, 0, errors.New("incomplete \\u escape")
+		}
+
+		v, err := strconv.ParseUint(string(rs[i+2:i+6]), 16, 32)
+		if err != nil {
+			return 0, 0, fmt.Errorf("invalid \\u escape %q", string(rs[i:i+6]))
+		}
+		return rune(v), 6, nil
+	}
+
+	return rs[i+1], 2, nil
+}
+
+// The addClass method adds the named class to the set.
+func (cs *CharSet) addClass(name string, ranges *[]runeRange) error {
+	if class, ok := posixClasses[name]; ok {
+		*ranges = append(*ranges, class...)
+		return nil
+	}
+
+	if name == "emoji" {
+		cs.tables = append(cs.tables, emojiTable)
+		return nil
+	}
+
+	if table, ok := unicode.Categories[name]; ok {
+		cs.tables = append(cs.tables, table)
+		return nil
+	}
+
+	if table, ok := unicode.Scripts[name]; ok {
+		cs.tables = append(cs.tables, table)
+		return nil
+	}
+
+	return fmt.Errorf("unknown class [:%s:]", name)
+}
+
+// ParseChars compiles the pattern into a CharSet and returns an error
+// if the pattern is invalid. See the Chars function for the syntax.
+//
+// Example usage:
+//
+//	cs, err := g.ParseChars("a-z0-9")  // cs.Contains('x') == true, nil
+//	cs, err = g.ParseChars("z-a")      // nil, error: invalid range
+func ParseChars(spec string) (*CharSet, error) {
+	cs := &CharSet{spec: spec}
+	rs := []rune(spec)
+	i := 0
+	if len(rs) > 0 && rs[0] == '^' {
+		cs.negate, i = true, 1
+	}
+
+	var ranges []runeRange
+	for i < len(rs) {
+		// Named class, e.g. [:digit:].
+		if rs[i] == '[' && i+1 < len(rs) && rs[i+1] == ':' {
+			end := -1
+			for j := i + 2; j+1 < len(rs); j++ {
+				if rs[j] == ':' && rs[j+1] == ']' {
+					end = j
+					break
+				}
+			}
+
+			if end < 0 {
+				return nil, fmt.Errorf("unterminated class at position %d", i)
+			}
+
+			if err := cs.addClass(string(rs[i+2:end]), &ranges); err != nil {
+				return nil, err
+			}
+			i = end + 2
+			continue
+		}
+
+		lo, n, err := parseCharsRune(rs, i)
+		if err != nil {
+			return nil, err
+		}
+		i += n
+
+		// Range, e.g. a-z; the dash at the end is a literal character.
+		hi := lo
+		if i+1 < len(rs) && rs[i] == '-' {
+			if hi, n, err = parseCharsRune(rs, i+1); err != nil {
+				return nil, err
+			}
+
+			if hi < lo {
+				return nil, fmt.Errorf("invalid range %q-%q", lo, hi)
+			}
+			i += n + 1
+		}
+
+		ranges = append(ranges, runeRange{lo, hi})
+	}
+
+	// ASCII characters go to the bitset, including ones matched by
+	// the tables, so the most common characters are checked at once.
+	for _, rr := range ranges {
+		for r := rr.lo; r <= rr.hi && r < utf8.RuneSelf; r++ {
+			cs.ascii[r>>6] |= 1 << (r & 63)
+		}
+	}
+
+	if len(cs.tables) != 0 {
+		for r := rune(0); r < utf8.RuneSelf; r++ {
+			if unicode.IsOneOf(cs.tables, r) {
+				cs.ascii[r>>6] |= 1 << (r & 63)
+			}
+		}
+	}
+
+	// Other characters are kept as sorted and merged ranges.
+	sort.Slice(ranges, func(i, j int) bool {
+		return ranges[i].lo < ranges[j].lo
+	})
+	for _, rr := range ranges {
+		if rr.hi < utf8.RuneSelf {
+			continue
+		}
+
+		rr.lo = If(rr.lo < utf8.RuneSelf, utf8.RuneSelf, rr.lo)
+		if last := len(cs.ranges) - 1; last >= 0 &&
+			rr.lo <= cs.ranges[last].hi+1 {
+			if rr.hi > cs.ranges[last].hi {
+				cs.ranges[last].hi = rr.hi
+			}
+			continue
+		}
+
+		cs.ranges = append(cs.ranges, rr)
+	}
+
+	return cs, nil
+}
+
+// Chars compiles the pattern into a CharSet. It panics if the pattern
+// is invalid, so it's intended for patterns known at compile time;
+// use ParseChars to handle errors.
+//
+// The pattern is a list of characters with the following syntax:
+//   - "a-z" is a range of characters, a dash at the beginning
+//     or at the end of the pattern is a literal dash;
+//   - "^" at the beginning negates the set, i.e. the set contains
+//     all characters except listed ones;
+//   - "\" escapes the next character, e.g. "\^" or "\-", and supports
+//     "\n", "\r", "\t", "\v", "\f", "\b" and "\uXXXX";
+//   - "[:name:]" is a named class: the ASCII classes of the regexp
+//     package (alnum, alpha, ascii, blank, cntrl, digit, graph, lower,
+//     print, punct, space, upper, word, xdigit), "emoji", Unicode
+//     categories (e.g. "L", "Lu", "Nd") and scripts (e.g. "Cyrillic").
+//
+// Example usage:
+//
+//	ident := g.Chars("a-zA-Z0-9_-")
+//	ident.Preserve("user_name@42!")         // Output: "user_name42"
+//	g.PreserveFunc("user_name@42!", ident)  // Output: "user_name42"
+//
+//	g.WeedFunc("user name@42!", g.Chars("^a-z"))
+//	// Output: "username"
+//
+//	g.TrimFunc(" (42). ", g.Chars("[:space:][:punct:]"))
+//	// Output: "42"
+func Chars(spec string) *CharSet {
+	cs, err := ParseChars(spec)
+	if err != nil {
+		panic(fmt.Sprintf("g: Chars(%q): %v", spec, err))
+	}
+
+	return cs
+}
@@ -0,0 +1,128 @@
+package g
+
+import "testing"
+
+// TestChars tests the Chars and ParseChars functions.
+func TestChars(t *testing.T) {
+	tests := []struct {
+		spec string
+		yes  string
+		no   string
+	}{
+		{"abc", "abc", "dA-"},
+		{"a-z", "amz", "A0-é"},
+		{"a-zA-Z0-9_-", "aZ5_-", " .@ї"},
+		{"-a", "-a", "b"},
+		{"^a-z", "A0 ї!", "az"},
+		{"^", "a ї", ""},
+		{"\\^x", "^x", "a"},
+		{"a\\-z", "a-z", "b"},
+		{"\\t\\n", "\t\n", " n"},
+		{"\\u0430-\\u044f", "аяж", "aєї"},
+		{"а-яіїєґ", "жїґ", "ёaЖ"},
+		{"[:digit:]", "0589", "a٣"},
+		{"[:alpha:][:digit:]_", "aZ0_", " ї"},
+		{"[:space:]", " \t\n\r\v\f", "a\u00a0"},
+		{"[:punct:]", "!.@[`~", "a «"},
+		{"[:xdigit:]", "09afAF", "gG"},
+		{"[:Cyrillic:]", "жЇ", "a1"},
+		{"[:Lu:]", "AЖ", "aж"},
+		{"[:emoji:]", "🚀😀", "a!"},
+		{"^[:L:][:space:]", "1!🚀", "aж "},
+		{"😀-😂x", "😀😁😂x", "🚀y"},
+		{"z-~a-c", "abcz{~", "dy"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.spec, func(t *testing.T) {
+			cs := Chars(tt.spec)
+			for _, r := range tt.yes {
+				if !cs.Contains(r) {
+					t.Errorf("Chars(%q).Contains(%q) = false", tt.spec, r)
+				}
+			}
+
+			for _, r := range tt.no {
+				if cs.Contains(r) {
+					t.Errorf("Chars(%q).Contains(%q) = true", tt.spec, r)
+				}
+			}
+
+			if cs.String() != tt.spec {
+				t.Errorf("String() = %q, want %q", cs.String(), tt.spec)
+			}
+		})
+	}
+
+	invalid := []string{"z-a", "[:digit", "[:unknown:]", "abc\\", "\\u12",
+		"\\u12zz"}
+	for _, spec := range invalid {
+		if _, err := ParseChars(spec); err == nil {
+			t.Errorf("ParseChars(%q) expected error", spec)
+		}
+	}
+
+	defer func() {
+		if recover() == nil {
+			t.Errorf("Chars() expected panic for invalid pattern")
+		}
+	}()
+	Chars("z-a")
+}
+
+// TestCharsPatterns tests the CharSet as a pattern of the WeedFunc,
+// PreserveFunc and TrimFunc functions.
+func TestCharsPatterns(t *testing.T) {
+	ident := Chars("a-zA-Z0-9_-")
+	if got := PreserveFunc("user_name@42!", ident); got != "user_name42" {
+		t.Errorf("PreserveFunc() = %q", got)
+	}
+
+	if got := WeedFunc("user name@42!", Chars("^a-z")); got != "username" {
+		t.Errorf("WeedFunc() = %q", got)
+	}
+
+	if got := WeedFunc("+380 (96) 123", Chars("^0-9")); got != "38096123" {
+		t.Errorf("WeedFunc() = %q", got)
+	}
+
+	got := TrimFunc(" (42). ", Chars("[:space:][:punct:]"))
+	if got != "42" {
+		t.Errorf("TrimFunc() = %q", got)
+	}
+
+	got = PreserveFunc("Ціна: 100 ₴", Chars("0-9"), UnicodeLetters)
+	if got != "Ціна100" {
+		t.Errorf("PreserveFunc() = %q", got)
+	}
+}
+
+// TestCharSetMethods tests the Weed, Preserve and Trim methods
+// of the CharSet.
+func TestCharSetMethods(t *testing.T) {
+	tests := []struct {
+		name string
+		got  string
+		want string
+	}{
+		{"Weed", Chars("^a-z").Weed("user name@42!"), "username"},
+		{"Weed digits", Chars("^0-9").Weed("+380 (96) 123"), "38096123"},
+		{"Weed class", Chars("[:Cyrillic:]").Weed("Go: Го"), "Go: "},
+		{"Weed nothing", Chars("x").Weed("abc"), "abc"},
+		{"Preserve", Chars("a-zA-Z0-9_-").Preserve("user_name@42!"),
+			"user_name42"},
+		{"Preserve unicode", Chars("а-яіїєґ ").Preserve("Ціна: 100 ₴"),
+			"іна  "},
+		{"Trim", Chars("[:space:][:punct:]").Trim(" (42). "), "42"},
+		{"Trim negated", Chars("^0-9").Trim("abc123def"), "123"},
+		{"Trim all", Chars("a-z").Trim("abc"), ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if tt.got != tt.want {
+				t.Errorf("got %q, want %q", tt.got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,185 @@
+package g
+
+import (
+	"fmt"
+	"math"
+	"math/bits"
+	"unsafe"
+)
+
+// The numericKind describes the properties of a numeric type
+// that matter when converting values between types.
+type numericKind struct {
+	float  bool   // floating-point type
+	signed bool   // signed integer or floating-point type
+	size   uint   // size of the type in bits
+	min    int64  // minimum value of the integer type
+	max    uint64 // maximum value of the integer type
+}
+
+// The kindOf function returns the numericKind of the type T.
+func kindOf[T Numerable]() numericKind {
+	var zero T
+	half := 0.5
+	k := numericKind{
+		float:  T(half) != 0,
+		signed: zero-1 < 0,
+		size:   uint(unsafe.Sizeof(zero)) * 8,
+	}
+
+	if !k.float {
+		if k.signed {
+			k.min, k.max = -1<<(k.size-1), 1<<(k.size-1)-1
+		} else {
+			k.max = math.MaxUint64 >> (64 - k.size)
+		}
+	}
+
+	return k
+}
+
+// Convert converts a numeric value of one type to another numeric type
+// and reports an error if the value cannot be represented exactly.
+//
+// The function returns an error if:
+//   - the value is greater than the maximum value of the target type
+//     (overflow);
+//   - the value is less than the minimum value of the target type
+//     (underflow), for example, a negative value converted to an
+//     unsigned type;
+//   - the value is NaN or infinity and the target type is an integer;
+//   - the value has a fractional part and the target type is an integer;
+//   - the integer value has more significant bits than the mantissa
+//     of the target floating-point type (24 for float32, 53 for
+//     float64), so it would be rounded, e.g. 1<<53 + 1 to float64.
+//
+// Conversion between floating-point types rounds the value to the
+// nearest representable one, only overflow is reported there.
+//
+// In the saturating mode (the optional s argument is true), values out
+// of range are clamped to the minimum or maximum value of the target
+// type, infinities are clamped as well, the fractional part is
+// truncated toward zero and integers are rounded to the nearest
+// floating-point value. Only NaN is reported as an error in this mode.
+//
+// On error, the function returns the zero value of the target type,
+// as the SafeSum function does.
+//
+// Example usage:
+//
+//	v, err := g.Convert[int8](100)          // 100, nil
+//	v, err = g.Convert[int8](300)           // 0, error: int8 overflow occurred
+//	v, err = g.Convert[uint](-1)            // 0, error: uint underflow occurred
+//	v, err = g.Convert[int](3.5)            // 0, error: fractional part lost
+//	v, err = g.Convert[float32](16777217)   // 0, error: precision lost
+//	v, err = g.Convert[int8](300, true)     // 127, nil
+//	v, err = g.Convert[int](3.5, true)      // 3, nil
+func Convert[To, From Numerable](v From, s ...bool) (To, error) {
+	saturate := All(s...)
+	to, from := kindOf[To](), kindOf[From]()
+
+	// Conversion from floating-point type.
+	if from.float {
+		f := float64(v)
+		if math.IsNaN(f) {
+			return 0, fmt.Errorf("NaN cannot be converted to %T", To(0))
+		}
+
+		if to.float {
+			if to.size == 32 && !math.IsInf(f, 0) &&
+				math.Abs(f) > math.MaxFloat32 {
+				if !saturate {
+					return 0, fmt.Errorf(
+						"%T %s occurred",
+						To(0),
+						If(f > 0, "overflow", "underflow"),
+					)
+				}
+				return To(math.Copysign(math.MaxFloat32, f)), nil
+			}
+			return To(f), nil
+		}
+
+		// The first value greater than the maximum of the integer type,
+		// it is exactly representable as float64, unlike the maximum.
+		limit := math.Ldexp(1, int(to.size)-If(to.signed, 1, 0))
+		t := math.Trunc(f)
+		switch {
+		case t >= limit:
+			if !saturate {
+				return 0, fmt.Errorf("%T overflow occurred", To(0))
+			}
+			return To(to.max), nil
+		case t < float64(to.min):
+			if !saturate {
+				return 0, fmt.Errorf("%T underflow occurred", To(0))
+			}
+			return To(to.min), nil
+		case t != f && !saturate:
+			return 0, fmt.Errorf(
+				"fractional part of %v is lost converting to %T",
+				f,
+				To(0),
+			)
+		}
+
+		return To(t), nil
+	}
+
+	// Conversion from integer type.
+	if to.float {
+		// The value is exact if its significant bits, without trailing
+		// zeros, fit into the mantissa (24 bits for float32, 53 bits
+		// for float64).
+		m := uint64(v)
+		if v < 0 {
+			m = -uint64(int64(v))
+		}
+
+		mantissa := If(to.size == 32, 24, 53)
+		if !saturate && bits.Len64(m)-bits.TrailingZeros64(m) > mantissa {
+			return 0, fmt.Errorf(
+				"precision of %v is lost converting to %T",
+				v,
+				To(0),
+			)
+		}
+		return To(v), nil
+	}
+
+	if v < 0 {
+		if i := int64(v); i < to.min {
+			if !saturate {
+				return 0, fmt.Errorf("%T underflow occurred", To(0))
+			}
+			return To(to.min), nil
+		}
+	} else if u := uint64(v); u > to.max {
+		if !saturate {
+			return 0, fmt.Errorf("%T overflow occurred", To(0))
+		}
+		return To(to.max), nil
+	}
+
+	return To(v), nil
+}
+
+// MustConvert converts a numeric value of one type to another numeric
+// type like the Convert function does, but panics if the value cannot
+// be represented exactly.
+//
+// It simplifies safe initialization of variables where conversion
+// errors are impossible or indicate a programming error.
+//
+// Example usage:
+//
+//	port := g.MustConvert[uint16](8080)  // 8080
+//	g.MustConvert[uint8](-1)             // panics: uint8 underflow occurred
+func MustConvert[To, From Numerable](v From) To {
+	r, err := Convert[To](v)
+	if err != nil {
+		panic(err)
+	}
+
+	return r
+}
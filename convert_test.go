@@ -0,0 +1,173 @@
+package g
+
+import (
+	"math"
+	"testing"
+)
+
+// TestConvertInteger tests the Convert function between integer types.
+func TestConvertInteger(t *testing.T) {
+	if v, err := Convert[int8](100); err != nil || v != 100 {
+		t.Errorf("Convert[int8](100) = %v, %v, want 100, nil", v, err)
+	}
+
+	if v, err := Convert[int8](300); err == nil || v != 0 {
+		t.Errorf("Convert[int8](300) = %v, %v, want overflow", v, err)
+	}
+
+	if v, err := Convert[int8](-300); err == nil || v != 0 {
+		t.Errorf("Convert[int8](-300) = %v, %v, want underflow", v, err)
+	}
+
+	if v, err := Convert[uint](-1); err == nil || v != 0 {
+		t.Errorf("Convert[uint](-1) = %v, %v, want underflow", v, err)
+	}
+
+	if v, err := Convert[int64](uint64(math.MaxUint64)); err == nil {
+		t.Errorf("Convert[int64](MaxUint64) = %v, %v, want overflow", v, err)
+	}
+
+	if v, err := Convert[uint64](int64(math.MaxInt64)); err != nil ||
+		v != math.MaxInt64 {
+		t.Errorf("Convert[uint64](MaxInt64) = %v, %v", v, err)
+	}
+
+	if v, err := Convert[int32](int64(math.MinInt32)); err != nil ||
+		v != math.MinInt32 {
+		t.Errorf("Convert[int32](MinInt32) = %v, %v", v, err)
+	}
+}
+
+// TestConvertFloat tests the Convert function from floating-point types.
+func TestConvertFloat(t *testing.T) {
+	tests := []struct {
+		name    string
+		v       float64
+		want    int
+		wantErr bool
+	}{
+		{"Whole", 42, 42, false},
+		{"Negative", -42, -42, false},
+		{"Fraction", 3.5, 0, true},
+		{"NaN", math.NaN(), 0, true},
+		{"Inf", math.Inf(1), 0, true},
+		{"NegInf", math.Inf(-1), 0, true},
+		{"Overflow", 1e20, 0, true},
+		{"Limit", math.Ldexp(1, 63), 0, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := Convert[int](tt.v)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("Convert() error = %v, wantErr %v", err, tt.wantErr)
+			}
+
+			if got != tt.want {
+				t.Errorf("Convert() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+
+	if v, err := Convert[uint8](-1.0); err == nil {
+		t.Errorf("Convert[uint8](-1.0) = %v, %v, want underflow", v, err)
+	}
+
+	if v, err := Convert[float32](1e300); err == nil {
+		t.Errorf("Convert[float32](1e300) = %v, %v, want overflow", v, err)
+	}
+
+	if v, err := Convert[float32](math.Inf(-1)); err != nil ||
+		!math.IsInf(float64(v), -1) {
+		t.Errorf("Convert[float32](-Inf) = %v, %v", v, err)
+	}
+
+	if v, err := Convert[float64](int64(-7)); err != nil || v != -7 {
+		t.Errorf("Convert[float64](-7) = %v, %v", v, err)
+	}
+}
+
+// TestConvertIntegerToFloat tests the Convert function
+// with integer values that don't fit into the mantissa.
+func TestConvertIntegerToFloat(t *testing.T) {
+	if v, err := Convert[float64](uint64(1<<53 + 1)); err == nil {
+		t.Errorf("Convert[float64](1<<53 + 1) = %v, want error", v)
+	}
+
+	if v, err := Convert[float32](int64(16777217)); err == nil {
+		t.Errorf("Convert[float32](16777217) = %v, want error", v)
+	}
+
+	if v, err := Convert[float32](int64(-16777217)); err == nil {
+		t.Errorf("Convert[float32](-16777217) = %v, want error", v)
+	}
+
+	// Large values with trailing zero bits are exact.
+	if v, err := Convert[float64](uint64(1 << 63)); err != nil ||
+		v != math.Ldexp(1, 63) {
+		t.Errorf("Convert[float64](1<<63) = %v, %v", v, err)
+	}
+
+	if v, err := Convert[float64](int64(math.MinInt64)); err != nil ||
+		v != -math.Ldexp(1, 63) {
+		t.Errorf("Convert[float64](MinInt64) = %v, %v", v, err)
+	}
+
+	if v, err := Convert[float32](int64(16777216)); err != nil ||
+		v != 16777216 {
+		t.Errorf("Convert[float32](16777216) = %v, %v", v, err)
+	}
+
+	if v, err := Convert[float64](uint64(1<<53+1), true); err != nil ||
+		v != math.Ldexp(1, 53) {
+		t.Errorf("Convert[float64](1<<53 + 1, true) = %v, %v", v, err)
+	}
+}
+
+// TestConvertSaturate tests the saturating mode of the Convert function.
+func TestConvertSaturate(t *testing.T) {
+	if v, err := Convert[int8](300, true); err != nil || v != math.MaxInt8 {
+		t.Errorf("Convert[int8](300, true) = %v, %v", v, err)
+	}
+
+	if v, err := Convert[int8](-300, true); err != nil || v != math.MinInt8 {
+		t.Errorf("Convert[int8](-300, true) = %v, %v", v, err)
+	}
+
+	if v, err := Convert[uint](-1, true); err != nil || v != 0 {
+		t.Errorf("Convert[uint](-1, true) = %v, %v", v, err)
+	}
+
+	if v, err := Convert[int](3.99, true); err != nil || v != 3 {
+		t.Errorf("Convert[int](3.99, true) = %v, %v", v, err)
+	}
+
+	if v, err := Convert[uint64](math.Inf(1), true); err != nil ||
+		v != math.MaxUint64 {
+		t.Errorf("Convert[uint64](+Inf, true) = %v, %v", v, err)
+	}
+
+	if v, err := Convert[float32](-1e300, true); err != nil ||
+		v != -math.MaxFloat32 {
+		t.Errorf("Convert[float32](-1e300, true) = %v, %v", v, err)
+	}
+
+	if _, err := Convert[int](math.NaN(), true); err == nil {
+		t.Errorf("Convert[int](NaN, true) expected error")
+	}
+}
+
+// TestMustConvert tests the MustConvert function.
+func TestMustConvert(t *testing.T) {
+	if v := MustConvert[uint16](8080); v != 8080 {
+		t.Errorf("MustConvert[uint16](8080) = %v, want 8080", v)
+	}
+
+	defer func() {
+		if r := recover(); r == nil {
+			t.Errorf("MustConvert[uint8](-1) did not panic")
+		}
+	}()
+
+	MustConvert[uint8](-1)
+}
@@ -1,12 +1,9 @@
 package g
 
 import (
-	"context"
 	"errors"
 	"fmt"
-	"runtime"
 	"strings"
-	"sync"
 	"time"
 )
 
@@ -19,6 +16,10 @@ var (
 	// reference time is echoed verbatim during Format and expected to appear
 	// verbatim in the input to Parse.
 	//
+	// The order of the layouts matters: the StringToDate function returns
+	// the result of the first matching layout, so the day-first layouts go
+	// before the month-first ones.
+	//
 	//	Year: "2006" "06"
 	//	Month: "Jan" "January" "01" "1"
 	//	Day of the week: "Mon" "Monday"
@@ -141,6 +142,105 @@ var (
 		"02-1-06 15:04:05",
 		"02-1-2006 15:04:05",
 
+		"1/2/06",
+		"1/2/2006",
+		"01/2/06",
+		"01/2/2006",
+		"01/02/2006",
+		"1/02/06",
+		"1/02/2006",
+
+		"1/2/06 15:04",
+		"1/2/2006 15:04",
+		"01/2/06 15:04",
+		"01/2/2006 15:04",
+		"01/02/2006 15:04",
+		"1/02/06 15:04",
+		"1/02/2006 15:04",
+
+		"1/2/06 15:04:05",
+		"1/2/2006 15:04:05",
+		"01/2/06 15:04:05",
+		"01/2/2006 15:04:05",
+		"01/02/2006 15:04:05",
+		"1/02/06 15:04:05",
+		"1/02/2006 15:04:05",
+
+		"1-2-06",
+		"1-2-2006",
+		"01-2-06",
+		"01-2-2006",
+		"01-02-2006",
+		"1-02-06",
+		"1-02-2006",
+
+		"1-2-06 15:04",
+		"1-2-2006 15:04",
+		"01-2-06 15:04",
+		"01-2-2006 15:04",
+		"01-02-2006 15:04",
+		"1-02-06 15:04",
+		"1-02-2006 15:04",
+
+		"1-2-06 15:04:05",
+		"1-2-2006 15:04:05",
+		"01-2-06 15:04:05",
+		"01-2-2006 15:04:05",
+		"01-02-2006 15:04:05",
+		"1-02-06 15:04:05",
+		"1-02-2006 15:04:05",
+
+		"06/1/2",
+		"2006/1/2",
+		"06/01/2",
+		"2006/01/2",
+		"2006/01/02",
+		"06/1/02",
+		"2006/1/02",
+
+		"06/1/2 15:04",
+		"2006/1/2 15:04",
+		"06/01/2 15:04",
+		"2006/01/2 15:04",
+		"2006/01/02 15:04",
+		"06/1/02 15:04",
+		"2006/1/02 15:04",
+
+		"06/1/2 15:04:05",
+		"2006/1/2 15:04:05",
+		"06/01/2 15:04:05",
+		"2006/01/2 15:04:05",
+		"2006/01/02 15:04:05",
+		"06/1/02 15:04:05",
+		"2006/1/02 15:04:05",
+
+		"06-1-2",
+		"2006-1-2",
+		"06-01-2",
+		"2006-01-2",
+		"06-1-02",
+		"2006-1-02",
+
+		"06-1-2 15:04",
+		"2006-1-2 15:04",
+		"06-01-2 15:04",
+		"2006-01-2 15:04",
+		"2006-01-02 15:04",
+		"06-1-02 15:04",
+		"2006-1-02 15:04",
+
+		"06-1-2 15:04:05",
+		"2006-1-2 15:04:05",
+		"06-01-2 15:04:05",
+		"2006-01-2 15:04:05",
+		"06-1-02 15:04:05",
+		"2006-1-02 15:04:05",
+	}
+
+	// The yearDayMonthFormats are rarely used year-day-month layouts,
+	// they are tried only if none of the dataTimeFormats matches, so that
+	// e.g. "2023-04-03" is neither ambiguous nor 4 March 2023.
+	yearDayMonthFormats = []string{
 		"06/2/1",
 		"2006/2/1",
 		"06/2/01",
@@ -188,52 +288,6 @@ var (
 		"2006-02-01 15:04:05",
 		"06-02-1 15:04:05",
 		"2006-02-1 15:04:05",
-
-		"06/1/2",
-		"2006/1/2",
-		"06/01/2",
-		"2006/01/2",
-		"2006/01/02",
-		"06/1/02",
-		"2006/1/02",
-
-		"06/1/2 15:04",
-		"2006/1/2 15:04",
-		"06/01/2 15:04",
-		"2006/01/2 15:04",
-		"2006/01/02 15:04",
-		"06/1/02 15:04",
-		"2006/1/02 15:04",
-
-		"06/1/2 15:04:05",
-		"2006/1/2 15:04:05",
-		"06/01/2 15:04:05",
-		"2006/01/2 15:04:05",
-		"2006/01/02 15:04:05",
-		"06/1/02 15:04:05",
-		"2006/1/02 15:04:05",
-
-		"06-1-2",
-		"2006-1-2",
-		"06-01-2",
-		"2006-01-2",
-		"06-1-02",
-		"2006-1-02",
-
-		"06-1-2 15:04",
-		"2006-1-2 15:04",
-		"06-01-2 15:04",
-		"2006-01-2 15:04",
-		"2006-01-02 15:04",
-		"06-1-02 15:04",
-		"2006-1-02 15:04",
-
-		"06-1-2 15:04:05",
-		"2006-1-2 15:04:05",
-		"06-01-2 15:04:05",
-		"2006-01-2 15:04:05",
-		"06-1-02 15:04:05",
-		"2006-1-02 15:04:05",
 	}
 
 	// The pythonToGolangFormats maps Python date format specifiers
@@ -269,43 +323,6 @@ var (
 	}
 )
 
-// dateParseError stores information about a date parsing error.
-type dateParseError struct {
-	layout string
-	err    error
-}
-
-// dateFoundValue is a thread-safe value that indicates
-// whether a date was found in a string.
-type dateFoundValue struct {
-	m     sync.Mutex
-	found bool
-	value time.Time
-}
-
-// SetValue sets a new value for the found object. It locks the Mutex
-// before changing the value and unlocks it after the change is complete.
-func (f *dateFoundValue) SetValue(found bool, value time.Time) {
-	f.m.Lock()
-	defer f.m.Unlock()
-
-	f.value = value
-	f.found = found
-}
-
-// GetValue retrieves the current value of the Found. It locks the Mutex
-// before reading the value and unlocks it after the read is complete.
-func (f *dateFoundValue) GetValue() (time.Time, error) {
-	f.m.Lock()
-	defer f.m.Unlock()
-
-	if f.found {
-		return f.value, nil
-	}
-
-	return time.Time{}, errors.New("date and time could not be recognized")
-}
-
 // The pythonToGolangFormat converts a Python date format specifier to a
 // GoLang date format specifier.
 func pythonToGolangFormat(format string) string {
@@ -332,13 +349,217 @@ func pythonToGolangFormat(format string) string {
 	return builder.String()
 }
 
+// ErrAmbiguousDate is the error reported by the ParseDate function
+// when the string matches several layouts that give different dates
+// and the RejectAmbiguous option is set. The returned error is
+// an *AmbiguousDateError, use errors.Is to check it.
+var ErrAmbiguousDate = errors.New("ambiguous date")
+
+// DateOrder is the preferred order of the elements of numeric dates,
+// such as "03/04/2023", that match several layouts.
+type DateOrder int
+
+const (
+	// LayoutOrder prefers the first matching layout in the list.
+	LayoutOrder DateOrder = iota
+
+	// DayFirst prefers the day-month-year order,
+	// "03/04/2023" is 3 April 2023.
+	DayFirst
+
+	// MonthFirst prefers the month-day-year order,
+	// "03/04/2023" is 4 March 2023.
+	MonthFirst
+
+	// YearFirst prefers the year-month-day order,
+	// "03/04/23" is 23 April 2003.
+	YearFirst
+)
+
+// DateOptions is a set of options for the ParseDate function.
+type DateOptions struct {
+	// Layouts are the layouts to try in order, in Go or Python (if the
+	// layout contains "%") style; the predefined layouts are used if
+	// it's empty.
+	Layouts []string
+
+	// Order is the preferred order of the day, month and year. If no
+	// layout with this order matches, the first matching layout is used.
+	Order DateOrder
+
+	// RejectAmbiguous makes the function return an *AmbiguousDateError
+	// instead of guessing, if the matching layouts give different dates.
+	RejectAmbiguous bool
+}
+
+// AmbiguousDateError describes a string that can be parsed
+// as different dates by the ParseDate function.
+type AmbiguousDateError struct {
+	Value   string      // string that was parsed
+	Dates   []time.Time // distinct dates, in the order of the layouts
+	Layouts []string    // first layout that gives each of the dates
+}
+
+// Error returns the description of the ambiguity with all dates.
+func (e *AmbiguousDateError) Error() string {
+	dates := make([]string, len(e.Dates))
+	for i, t := range e.Dates {
+		dates[i] = fmt.Sprintf("%s (%s)", t.Format(time.RFC3339), e.Layouts[i])
+	}
+
+	return fmt.Sprintf("%v %q: %s",
+		ErrAmbiguousDate, e.Value, strings.Join(dates, ", "))
+}
+
+// Unwrap returns the ErrAmbiguousDate error.
+func (e *AmbiguousDateError) Unwrap() error {
+	return ErrAmbiguousDate
+}
+
+// The dateOrderOf function returns the order of the date in the layout
+// by its first element: the day, the month (numeric or its name, e.g.
+// "Jan 2, 2006" is month-first) or the year. It returns LayoutOrder
+// if the layout has no date elements.
+func dateOrderOf(layout string) DateOrder {
+	for i := 0; i < len(layout); i++ {
+		rest := layout[i:]
+		switch {
+		case strings.HasPrefix(rest, "2006"), strings.HasPrefix(rest, "06"):
+			return YearFirst
+		case strings.HasPrefix(rest, "15"):
+			i++ // hour
+		case strings.HasPrefix(rest, "__2"), strings.HasPrefix(rest, "002"):
+			i += 2 // day of the year
+		case strings.HasPrefix(rest, "01"), rest[0] == '1',
+			strings.HasPrefix(rest, "Jan"): // "Jan" or "January"
+			return MonthFirst
+		case strings.HasPrefix(rest, "02"), strings.HasPrefix(rest, "_2"),
+			rest[0] == '2':
+			return DayFirst
+		}
+	}
+
+	return LayoutOrder
+}
+
+// ParseDate converts a string to a time.Time object, trying the layouts
+// in order. The result of the first matching layout is returned, unless
+// the Order option prefers a layout with another order of the date
+// elements, or the RejectAmbiguous option is set and the matching
+// layouts give different dates, in which case an *AmbiguousDateError
+// is returned.
+//
+// Example usage:
+//
+//	g.ParseDate("03/04/2023")
+//	// Output: 2023-04-03 00:00:00 +0000 UTC, nil
+//
+//	g.ParseDate("03/04/2023", g.DateOptions{Order: g.MonthFirst})
+//	// Output: 2023-03-04 00:00:00 +0000 UTC, nil
+//
+//	_, err := g.ParseDate("03/04/2023", g.DateOptions{RejectAmbiguous: true})
+//	errors.Is(err, g.ErrAmbiguousDate)  // Output: true
+//	// err: ambiguous date "03/04/2023": 2023-04-03T00:00:00Z (2/1/2006),
+//	// 2023-03-04T00:00:00Z (1/2/2006)
+func ParseDate(s string, opts ...DateOptions) (time.Time, error) {
+	var opt DateOptions
+	if len(opts) > 0 {
+		opt = opts[0]
+	}
+
+	formats := make([]string, 0, len(opt.Layouts))
+	for _, layout := range opt.Layouts {
+		if strings.Contains(layout, "%") {
+			formats = append(formats, pythonToGolangFormat(layout))
+		} else {
+			formats = append(formats, layout)
+		}
+	}
+
+	// The year-day-month layouts are tried only
+	// if none of the predefined layouts matches.
+	groups := [][]string{formats}
+	if len(formats) == 0 {
+		groups = [][]string{dataTimeFormats, yearDayMonthFormats}
+	}
+
+	var errorsList []error
+	for _, group := range groups {
+		if t, ok, err := parseDate(s, group, opt, &errorsList); ok || err != nil {
+			return t, err
+		}
+	}
+
+	return time.Time{}, fmt.Errorf("failed to parse date: %v", errorsList)
+}
+
+// The parseDate function parses the string by the layouts according
+// to the options, see ParseDate. It returns false if no layout matches,
+// the parsing errors are appended to the errorsList.
+func parseDate(
+	s string,
+	formats []string,
+	opt DateOptions,
+	errorsList *[]error,
+) (time.Time, bool, error) {
+	var (
+		found     bool
+		result    time.Time
+		ambiguity = &AmbiguousDateError{Value: s}
+	)
+
+	for _, layout := range formats {
+		t, err := time.Parse(layout, s)
+		if err != nil {
+			*errorsList = append(*errorsList,
+				fmt.Errorf("format %s: %w", layout, err))
+			continue
+		}
+
+		switch {
+		case opt.RejectAmbiguous:
+			seen := false
+			for _, d := range ambiguity.Dates {
+				if d.Equal(t) {
+					seen = true
+					break
+				}
+			}
+
+			if !seen {
+				ambiguity.Dates = append(ambiguity.Dates, t)
+				ambiguity.Layouts = append(ambiguity.Layouts, layout)
+			}
+		case opt.Order == LayoutOrder || dateOrderOf(layout) == opt.Order:
+			return t, true, nil
+		}
+
+		if !found {
+			found, result = true, t
+		}
+	}
+
+	if len(ambiguity.Dates) > 1 {
+		return time.Time{}, false, ambiguity
+	}
+
+	return result, found, nil
+}
+
 // StringToDate converts a string to a time.Time object using the provided
 // formats. If no format is given, it uses default date-time formats.
 //
-// This function leverages goroutines to parse the string concurrently
-// using different formats. When the first successful parsing occurs,
-// the function stops all other goroutines and returns the result.
-// If no parsing is successful, the function returns an error.
+// The formats are tried in order and the result of the first matching
+// one is returned, so the result is deterministic: e.g. "03/04/2023" is
+// 3 April 2023 with the default formats. Use the ParseDate function
+// to choose the order of the day, month and year or to reject
+// ambiguous dates. If no parsing is successful, the function
+// returns an error.
+//
+// The default formats include month-first layouts (e.g. "01/02/2006")
+// after the day-first ones, so a date that is valid only in the
+// month-first order, such as "12/25/2023", is parsed as well, while
+// earlier versions of the function returned an error for it.
 //
 // Example usage:
 //
@@ -369,84 +590,7 @@ func pythonToGolangFormat(format string) string {
 //	}
 //	fmt.Println(t)
 func StringToDate(s string, patterns ...string) (time.Time, error) {
-	var (
-		formats []string
-		wg      sync.WaitGroup
-	)
-
-	for _, pattern := range patterns {
-		if strings.Contains(pattern, "%") {
-			formats = append(formats, pythonToGolangFormat(pattern))
-		} else {
-			formats = append(formats, pattern)
-		}
-	}
-
-	if len(formats) == 0 {
-		formats = dataTimeFormats
-	}
-
-	errorChan := make(chan dateParseError, len(formats))
-	resultChan := make(chan time.Time, 1)
-	ctx, cancel := context.WithCancel(context.Background())
-	defer cancel()
-
-	sem := make(chan struct{}, runtime.GOMAXPROCS(0)) // limit goroutines
-
-	for _, format := range formats {
-		wg.Add(1)
-		sem <- struct{}{} // acquire a spot in the semaphore
-		go func(layout string) {
-			defer wg.Done()
-			defer func() { <-sem }() // release the spot in the semaphore
-
-			select {
-			case <-ctx.Done():
-				return
-			default:
-				if t, err := time.Parse(layout, s); err == nil {
-					select {
-					case resultChan <- t:
-					default:
-					}
-					cancel()
-				} else {
-					errorChan <- dateParseError{layout, err}
-				}
-			}
-		}(format)
-	}
-
-	// Close the error channel when all goroutines are done.
-	go func() {
-		wg.Wait()
-		close(errorChan)
-	}()
-
-	// Get result from channels.
-	var errorsList []error
-	for {
-		select {
-		case t := <-resultChan:
-			return t, nil
-		case parseErr, ok := <-errorChan:
-			if ok {
-				errorsList = append(
-					errorsList,
-					fmt.Errorf("format %s: %w", parseErr.layout, parseErr.err),
-				)
-			} else {
-				// If the channel is closed and there are no more errors.
-				if len(errorsList) > 0 {
-					return time.Time{}, fmt.Errorf(
-						"failed to parse date: %v",
-						errorsList,
-					)
-				}
-				return time.Time{}, fmt.Errorf("failed to parse date")
-			}
-		}
-	}
+	return ParseDate(s, DateOptions{Layouts: patterns})
 }
 
 // DateToString converts a Date to a string based on the provided format.
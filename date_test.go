@@ -1,17 +1,20 @@
 package g
 
 import (
+	"errors"
 	"reflect"
 	"strings"
-	"sync"
 	"testing"
 	"time"
 )
 
-// TestFindDuplicatesDateTimeFormats tests dataTimeFormats.
+// TestFindDuplicatesDateTimeFormats tests dataTimeFormats
+// and yearDayMonthFormats.
 func TestFindDuplicatesDateTimeFormats(t *testing.T) {
 	seen := make(map[string]bool)
-	for _, format := range dataTimeFormats {
+	formats := append(dataTimeFormats[:len(dataTimeFormats):len(dataTimeFormats)],
+		yearDayMonthFormats...)
+	for _, format := range formats {
 		if seen[format] {
 			t.Errorf("Duplicate format found: %s", format)
 		}
@@ -33,97 +36,8 @@ func TestStringToDate_ImpossibleState(t *testing.T) {
 	}
 }
 
-// TestDateFoundValue_SetValue tests SetValue method of dateFoundValue.
-func TestDateFoundValue_SetValue(t *testing.T) {
-	dfv := &dateFoundValue{}
-	expectedTime := time.Date(2023, 12, 1, 15, 30, 45, 0, time.UTC)
-
-	// Test setting true with time
-	dfv.SetValue(true, expectedTime)
-	if !dfv.found {
-		t.Error("Expected found to be true")
-	}
-	if dfv.value != expectedTime {
-		t.Errorf("Expected time to be %v, got %v", expectedTime, dfv.value)
-	}
-
-	// Test setting false with zero time
-	zeroTime := time.Time{}
-	dfv.SetValue(false, zeroTime)
-	if dfv.found {
-		t.Error("Expected found to be false")
-	}
-	if dfv.value != zeroTime {
-		t.Errorf("Expected time to be %v, got %v", zeroTime, dfv.value)
-	}
-}
-
-func TestDateFoundValue_GetValue(t *testing.T) {
-	dfv := &dateFoundValue{}
-	expectedTime := time.Date(2023, 12, 1, 15, 30, 45, 0, time.UTC)
-
-	// Test getting value when not found
-	_, err := dfv.GetValue()
-	if err == nil {
-		t.Error("Expected error when value not found")
-	}
-	if err.Error() != "date and time could not be recognized" {
-		t.Errorf(
-			"Expected error message 'date and time could not be "+
-				"recognized',got '%s'", err.Error())
-	}
-
-	// Test getting value when found
-	dfv.SetValue(true, expectedTime)
-	value, err := dfv.GetValue()
-	if err != nil {
-		t.Errorf("Unexpected error: %v", err)
-	}
-	if value != expectedTime {
-		t.Errorf("Expected time to be %v, got %v", expectedTime, value)
-	}
-}
-
-func TestDateFoundValue_Concurrent(t *testing.T) {
-	dfv := &dateFoundValue{}
-	expectedTime := time.Date(2023, 12, 1, 15, 30, 45, 0, time.UTC)
-	concurrentAccess := 100
-	var wg sync.WaitGroup
-
-	// Test concurrent access
-	for i := 0; i < concurrentAccess; i++ {
-		wg.Add(2)
-
-		// Concurrent SetValue
-		go func() {
-			defer wg.Done()
-			dfv.SetValue(true, expectedTime)
-		}()
-
-		// Concurrent GetValue
-		go func() {
-			defer wg.Done()
-			dfv.GetValue()
-		}()
-	}
-
-	wg.Wait()
-
-	// Verify final state
-	value, err := dfv.GetValue()
-	if err != nil {
-		t.Errorf("Unexpected error after concurrent access: %v", err)
-	}
-	if value != expectedTime {
-		t.Errorf("Expected time to be %v after concurrent access, got %v",
-			expectedTime, value)
-	}
-}
-
 // TestStringToDate tests StringToDate function.
 func TestStringToDate(t *testing.T) {
-	minLoadPerGoroutine = 4
-	ParallelTasks(2)
 	tests := []struct {
 		input    string
 		patterns []string
@@ -185,7 +99,7 @@ func TestStringToDate(t *testing.T) {
 			true,
 		},
 
-		// Gorutines.
+		// Several patterns, the first matching one wins.
 		{
 			"16/07/2023 14:00:00",
 			[]string{
@@ -199,6 +113,38 @@ func TestStringToDate(t *testing.T) {
 			time.Date(2023, time.July, 16, 14, 0, 0, 0, time.UTC),
 			false,
 		},
+		{
+			"03/04/2023",
+			[]string{"01/02/2006", "02/01/2006"},
+			time.Date(2023, time.March, 4, 0, 0, 0, 0, time.UTC),
+			false,
+		},
+
+		// Ambiguous dates with the default formats.
+		{
+			"03/04/2023",
+			nil,
+			time.Date(2023, time.April, 3, 0, 0, 0, 0, time.UTC),
+			false,
+		},
+		{
+			"2023-04-03 10:30",
+			nil,
+			time.Date(2023, time.April, 3, 10, 30, 0, 0, time.UTC),
+			false,
+		},
+		{
+			"2023/25/12",
+			nil,
+			time.Date(2023, time.December, 25, 0, 0, 0, 0, time.UTC),
+			false,
+		},
+		{
+			"12/25/2023",
+			nil,
+			time.Date(2023, time.December, 25, 0, 0, 0, 0, time.UTC),
+			false,
+		},
 	}
 
 	for _, test := range tests {
@@ -215,6 +161,153 @@ func TestStringToDate(t *testing.T) {
 	}
 }
 
+// TestParseDate tests the ParseDate function.
+func TestParseDate(t *testing.T) {
+	tests := []struct {
+		name  string
+		input string
+		opts  DateOptions
+		want  time.Time
+	}{
+		{
+			name:  "Layout order",
+			input: "03/04/2023",
+			want:  time.Date(2023, time.April, 3, 0, 0, 0, 0, time.UTC),
+		},
+		{
+			name:  "Day first",
+			input: "03/04/2023",
+			opts:  DateOptions{Order: DayFirst},
+			want:  time.Date(2023, time.April, 3, 0, 0, 0, 0, time.UTC),
+		},
+		{
+			name:  "Month first",
+			input: "03/04/2023",
+			opts:  DateOptions{Order: MonthFirst},
+			want:  time.Date(2023, time.March, 4, 0, 0, 0, 0, time.UTC),
+		},
+		{
+			name:  "Year first",
+			input: "03/04/23",
+			opts:  DateOptions{Order: YearFirst},
+			want:  time.Date(2003, time.April, 23, 0, 0, 0, 0, time.UTC),
+		},
+		{
+			name:  "Preferred order doesn't match",
+			input: "25/12/2023",
+			opts:  DateOptions{Order: MonthFirst},
+			want:  time.Date(2023, time.December, 25, 0, 0, 0, 0, time.UTC),
+		},
+		{
+			name:  "Custom layouts",
+			input: "03.04.2023",
+			opts: DateOptions{
+				Layouts: []string{"%d.%m.%Y", "01.02.2006"},
+				Order:   MonthFirst,
+			},
+			want: time.Date(2023, time.March, 4, 0, 0, 0, 0, time.UTC),
+		},
+		{
+			name:  "Unambiguous date",
+			input: "2023-12-01",
+			opts:  DateOptions{RejectAmbiguous: true},
+			want:  time.Date(2023, time.December, 1, 0, 0, 0, 0, time.UTC),
+		},
+		{
+			name:  "Same date by several layouts",
+			input: "25/12/2023",
+			opts:  DateOptions{RejectAmbiguous: true},
+			want:  time.Date(2023, time.December, 25, 0, 0, 0, 0, time.UTC),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Repeat to make sure the result is deterministic.
+			for i := 0; i < 10; i++ {
+				got, err := ParseDate(tt.input, tt.opts)
+				if err != nil {
+					t.Fatalf("ParseDate(%q) error: %v", tt.input, err)
+				}
+
+				if !got.Equal(tt.want) {
+					t.Fatalf("ParseDate(%q) = %v, want %v",
+						tt.input, got, tt.want)
+				}
+			}
+		})
+	}
+}
+
+// TestParseDateAmbiguous tests the ParseDate function
+// with the RejectAmbiguous option.
+func TestParseDateAmbiguous(t *testing.T) {
+	_, err := ParseDate("03/04/2023", DateOptions{RejectAmbiguous: true})
+	if !errors.Is(err, ErrAmbiguousDate) {
+		t.Fatalf("ParseDate error = %v, want ErrAmbiguousDate", err)
+	}
+
+	var ambiguous *AmbiguousDateError
+	if !errors.As(err, &ambiguous) {
+		t.Fatalf("ParseDate error type = %T, want *AmbiguousDateError", err)
+	}
+
+	want := []time.Time{
+		time.Date(2023, time.April, 3, 0, 0, 0, 0, time.UTC),
+		time.Date(2023, time.March, 4, 0, 0, 0, 0, time.UTC),
+	}
+	if !reflect.DeepEqual(ambiguous.Dates, want) {
+		t.Errorf("Dates = %v, want %v", ambiguous.Dates, want)
+	}
+
+	layouts := []string{"2/1/2006", "1/2/2006"}
+	if !reflect.DeepEqual(ambiguous.Layouts, layouts) {
+		t.Errorf("Layouts = %v, want %v", ambiguous.Layouts, layouts)
+	}
+
+	msg := `ambiguous date "03/04/2023": ` +
+		"2023-04-03T00:00:00Z (2/1/2006), 2023-03-04T00:00:00Z (1/2/2006)"
+	if err.Error() != msg {
+		t.Errorf("Error() = %q, want %q", err.Error(), msg)
+	}
+
+	// Failed parsing isn't reported as ambiguity.
+	_, err = ParseDate("invalid date", DateOptions{RejectAmbiguous: true})
+	if err == nil || errors.Is(err, ErrAmbiguousDate) {
+		t.Errorf("ParseDate(%q) error = %v, want parse error",
+			"invalid date", err)
+	}
+}
+
+// TestDateOrderOf tests the dateOrderOf function.
+func TestDateOrderOf(t *testing.T) {
+	tests := []struct {
+		layout string
+		want   DateOrder
+	}{
+		{"02/01/2006", DayFirst},
+		{"_2 Jan 2006", DayFirst},
+		{"01/02/2006", MonthFirst},
+		{"Jan 2, 2006", MonthFirst},
+		{"January 02 06", MonthFirst},
+		{"2 January 2006", DayFirst},
+		{time.ANSIC, MonthFirst},
+		{time.RFC850, DayFirst},
+		{"2006-01-02", YearFirst},
+		{"06/1/2 15:04", YearFirst},
+		{"15:04 02.01.2006", DayFirst},
+		{"002 2006", YearFirst},
+		{time.Kitchen, LayoutOrder},
+		{time.TimeOnly, LayoutOrder},
+	}
+
+	for _, tt := range tests {
+		if got := dateOrderOf(tt.layout); got != tt.want {
+			t.Errorf("dateOrderOf(%q) = %v, want %v", tt.layout, got, tt.want)
+		}
+	}
+}
+
 // TestDateToString tests DateToString function.
 func TestDateToString(t *testing.T) {
 	t1 := time.Date(2023, 7, 17, 0, 0, 0, 0, time.UTC)
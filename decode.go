@@ -0,0 +1,407 @@
+package g
+
+import (
+	"encoding"
+	"errors"
+	"fmt"
+	"os"
+	"reflect"
+	"strings"
+	"time"
+)
+
+// DecodeOptions is a set of options for the Decode function.
+type DecodeOptions struct {
+	// Separator is used to split values of slice fields,
+	// the comma is used if it's empty.
+	Separator string
+
+	// Delimiter joins the names of nested structures and their fields
+	// into keys, e.g. "db.host"; the dot is used if it's empty.
+	Delimiter string
+
+	// UpperCase converts the keys to upper case, e.g. "DB.HOST".
+	UpperCase bool
+}
+
+// FieldError describes an error of decoding a single field
+// by the Decode and DecodeEnv functions.
+type FieldError struct {
+	Field string // path to the field, e.g. "DB.Port"
+	Key   string // key in the source, e.g. "db.port"
+	Value string // value that could not be converted
+	Err   error  // reason of the failure
+}
+
+// Error returns the description of the field error.
+func (e *FieldError) Error() string {
+	return fmt.Sprintf("%s (%s=%q): %v", e.Field, e.Key, e.Value, e.Err)
+}
+
+// Unwrap returns the reason of the field error.
+func (e *FieldError) Unwrap() error {
+	return e.Err
+}
+
+// DecodeError is a report of all field errors that occurred
+// during decoding by the Decode and DecodeEnv functions.
+type DecodeError struct {
+	Errors []*FieldError
+}
+
+// Error returns the description of all field errors.
+func (e *DecodeError) Error() string {
+	msgs := make([]string, len(e.Errors))
+	for i, err := range e.Errors {
+		msgs[i] = err.Error()
+	}
+
+	return fmt.Sprintf(
+		"failed to decode %d field(s): %s",
+		len(e.Errors),
+		strings.Join(msgs, "; "),
+	)
+}
+
+// Unwrap returns all field errors, so that the errors.Is and
+// errors.As functions can inspect them.
+func (e *DecodeError) Unwrap() []error {
+	result := make([]error, len(e.Errors))
+	for i, err := range e.Errors {
+		result[i] = err
+	}
+
+	return result
+}
+
+// The fieldTag is a parsed `g:"name,default=...,format=..."` tag.
+type fieldTag struct {
+	name       string
+	def        string
+	hasDefault bool
+	format     string
+	required   bool
+	skip       bool
+}
+
+// The parseFieldTag function parses the tag of the struct field.
+//
+// The default value can contain commas, so everything after
+// "default=" up to the next known option is taken as the value.
+func parseFieldTag(field reflect.StructField) fieldTag {
+	tag, ok := field.Tag.Lookup("g")
+	if tag == "-" {
+		return fieldTag{skip: true}
+	}
+
+	result := fieldTag{name: field.Name}
+	if !ok {
+		return result
+	}
+
+	parts := strings.Split(tag, ",")
+	if parts[0] != "" {
+		result.name = parts[0]
+	}
+
+	for i := 1; i < len(parts); i++ {
+		switch part := parts[i]; {
+		case strings.HasPrefix(part, "default="):
+			value := strings.TrimPrefix(part, "default=")
+			for i+1 < len(parts) && !isFieldTagOption(parts[i+1]) {
+				i++
+				value += "," + parts[i]
+			}
+			result.def, result.hasDefault = value, true
+		case strings.HasPrefix(part, "format="):
+			result.format = strings.TrimPrefix(part, "format=")
+		case part == "required":
+			result.required = true
+		}
+	}
+
+	return result
+}
+
+// The isFieldTagOption function checks if the part
+// of the tag is a known option.
+func isFieldTagOption(part string) bool {
+	return part == "required" ||
+		strings.HasPrefix(part, "default=") ||
+		strings.HasPrefix(part, "format=")
+}
+
+// The decoder holds the state of the Decode function.
+type decoder struct {
+	src      map[string]string
+	opts     DecodeOptions
+	errors   []*FieldError
+	visiting map[reflect.Type]bool // structs being decoded, to stop on cycles
+}
+
+// The hasPrefix method checks if the source has a key
+// of a field of the nested structure with the prefix.
+func (d *decoder) hasPrefix(prefix string) bool {
+	prefix += d.opts.Delimiter
+	for key := range d.src {
+		if strings.HasPrefix(key, prefix) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// The decodeStruct method fills the fields of the struct from
+// the source. The prefix is prepended to the keys and the path to
+// the field names in errors. It returns true if at least one field
+// was found in the source or has a default value.
+func (d *decoder) decodeStruct(rv reflect.Value, prefix, path string) bool {
+	found := false
+	rt := rv.Type()
+	if d.visiting == nil {
+		d.visiting = make(map[reflect.Type]bool)
+	}
+
+	// A recursive type, e.g. a linked list node, is filled
+	// only as deep as the source has keys for it.
+	if !d.visiting[rt] {
+		d.visiting[rt] = true
+		defer delete(d.visiting, rt)
+	}
+
+	for i := 0; i < rt.NumField(); i++ {
+		field, fv := rt.Field(i), rv.Field(i)
+		tag := parseFieldTag(field)
+		if tag.skip || !fv.CanSet() {
+			continue
+		}
+
+		key := tag.name
+		if prefix != "" {
+			key = prefix + d.opts.Delimiter + key
+		}
+		if d.opts.UpperCase {
+			key = strings.ToUpper(key)
+		}
+
+		name := field.Name
+		if path != "" {
+			name = path + "." + name
+		}
+
+		// Nested structures.
+		if isNestedStruct(fv.Type()) {
+			if fv.Kind() == reflect.Pointer {
+				if d.visiting[fv.Type().Elem()] && !d.hasPrefix(key) {
+					continue
+				}
+
+				nested := reflect.New(fv.Type().Elem())
+				if d.decodeStruct(nested.Elem(), key, name) {
+					fv.Set(nested)
+					found = true
+				}
+			} else if d.decodeStruct(fv, key, name) {
+				found = true
+			}
+			continue
+		}
+
+		value, ok := d.src[key]
+		if !ok && tag.hasDefault {
+			value, ok = tag.def, true
+		}
+
+		if !ok {
+			if tag.required {
+				d.errors = append(d.errors, &FieldError{
+					Field: name,
+					Key:   key,
+					Err:   errors.New("missing required value"),
+				})
+			}
+			continue
+		}
+
+		found = true
+		if err := d.decodeValue(fv, value, tag.format); err != nil {
+			d.errors = append(d.errors, &FieldError{
+				Field: name,
+				Key:   key,
+				Value: value,
+				Err:   err,
+			})
+		}
+	}
+
+	return found
+}
+
+// The decodeValue method converts the string to the type of the value
+// and sets the result. The format is used for the time.Time values.
+func (d *decoder) decodeValue(rv reflect.Value, s, format string) error {
+	if rv.Kind() == reflect.Pointer {
+		elem := reflect.New(rv.Type().Elem())
+		if err := d.decodeValue(elem.Elem(), s, format); err != nil {
+			return err
+		}
+
+		rv.Set(elem)
+		return nil
+	}
+
+	switch p := rv.Addr().Interface().(type) {
+	case *time.Time:
+		t, err := StringToDate(s, If(format != "", []string{format}, nil)...)
+		if err != nil {
+			return err
+		}
+		*p = t
+		return nil
+	case *time.Duration:
+		t, err := time.ParseDuration(s)
+		if err != nil {
+			return err
+		}
+		*p = t
+		return nil
+	case encoding.TextUnmarshaler:
+		return p.UnmarshalText([]byte(s))
+	}
+
+	if rv.Kind() == reflect.Slice {
+		parts := []string{}
+		if strings.TrimSpace(s) != "" {
+			parts = strings.Split(s, d.opts.Separator)
+		}
+
+		slice := reflect.MakeSlice(rv.Type(), len(parts), len(parts))
+		for i, part := range parts {
+			err := d.decodeValue(slice.Index(i), strings.TrimSpace(part), format)
+			if err != nil {
+				return fmt.Errorf("element %d: %w", i, err)
+			}
+		}
+
+		rv.Set(slice)
+		return nil
+	}
+
+	if rv.Kind() != reflect.String && s == "" {
+		return errors.New("empty value")
+	}
+
+	return setFromString(rv, s)
+}
+
+// The isNestedStruct function checks if the type is a struct
+// or a pointer to a struct that should be decoded field by field.
+func isNestedStruct(t reflect.Type) bool {
+	if t.Kind() == reflect.Pointer {
+		t = t.Elem()
+	}
+
+	textUnmarshaler := reflect.TypeOf((*encoding.TextUnmarshaler)(nil)).Elem()
+	return t.Kind() == reflect.Struct &&
+		t != reflect.TypeOf(time.Time{}) &&
+		!reflect.PointerTo(t).Implements(textUnmarshaler)
+}
+
+// Decode fills the fields of the structure pointed to by dst with
+// values from the src map, converting them to the types of the fields.
+//
+// Fields are matched by the `g` tag in the form
+// `g:"name,default=value,format=layout,required"`:
+//   - name is the key in the src map, the field name is used if
+//     it's omitted; the "-" tag skips the field;
+//   - default is the value used if the key is missing;
+//   - format is the layout for the time.Time fields, in Go or Python
+//     (strftime) style, as in the StringToDate function;
+//   - required reports an error if the key is missing and
+//     there is no default value.
+//
+// Supported field types: strings, booleans and numbers (see StringTo),
+// time.Time, time.Duration, types implementing encoding.TextUnmarshaler,
+// pointers to them and slices of them (values are split by the
+// separator from the options). Nested structures are filled from keys
+// prefixed with the name of the structure field, e.g. "db.host".
+//
+// The function does not stop on the first error, all field errors are
+// collected into a *DecodeError report.
+//
+// Example usage:
+//
+//	type Config struct {
+//	    Host    string        `g:"host,default=localhost"`
+//	    Port    int           `g:"port,required"`
+//	    Debug   bool          `g:"debug"`
+//	    Timeout time.Duration `g:"timeout,default=30s"`
+//	    Tags    []string      `g:"tags"`
+//	    Since   time.Time     `g:"since,format=%Y-%m-%d"`
+//	    DB      struct {
+//	        User string `g:"user"`
+//	    } `g:"db"`
+//	}
+//
+//	var cfg Config
+//	err := g.Decode(&cfg, map[string]string{
+//	    "port":    "8080",
+//	    "tags":    "a, b, c",
+//	    "since":   "2023-07-17",
+//	    "db.user": "admin",
+//	})
+func Decode(dst any, src map[string]string, opts ...DecodeOptions) error {
+	rv := reflect.ValueOf(dst)
+	if rv.Kind() != reflect.Pointer || rv.IsNil() ||
+		rv.Elem().Kind() != reflect.Struct {
+		return fmt.Errorf("dst must be a non-nil pointer to a struct, got %T", dst)
+	}
+
+	d := &decoder{src: src}
+	if len(opts) > 0 {
+		d.opts = opts[0]
+	}
+	d.opts.Separator = If(d.opts.Separator == "", ",", d.opts.Separator)
+	d.opts.Delimiter = If(d.opts.Delimiter == "", ".", d.opts.Delimiter)
+
+	d.decodeStruct(rv.Elem(), "", "")
+	if len(d.errors) != 0 {
+		return &DecodeError{Errors: d.errors}
+	}
+
+	return nil
+}
+
+// DecodeEnv fills the fields of the structure pointed to by dst with
+// values of the environment variables, as the Decode function does.
+//
+// Variable names are the upper-cased keys from the `g` tags joined
+// with the underscore and prefixed with the prefix, e.g. with the
+// "APP" prefix, the `g:"port"` field of the `g:"db"` nested structure
+// is filled from the APP_DB_PORT variable.
+//
+// Example usage:
+//
+//	type Config struct {
+//	    Port  int  `g:"port,default=8080"`
+//	    Debug bool `g:"debug"`
+//	}
+//
+//	// APP_PORT=9000 APP_DEBUG=yes
+//	var cfg Config
+//	err := g.DecodeEnv(&cfg, "APP")
+func DecodeEnv(dst any, prefix string) error {
+	src := make(map[string]string)
+	prefix = strings.ToUpper(strings.TrimSuffix(prefix, "_"))
+	for _, env := range os.Environ() {
+		key, value, _ := strings.Cut(env, "=")
+		if prefix == "" {
+			src[key] = value
+		} else if strings.HasPrefix(key, prefix+"_") {
+			src[strings.TrimPrefix(key, prefix+"_")] = value
+		}
+	}
+
+	return Decode(dst, src, DecodeOptions{Delimiter: "_", UpperCase: true})
+}
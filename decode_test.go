@@ -0,0 +1,197 @@
+package g
+
+import (
+	"errors"
+	"net/netip"
+	"reflect"
+	"strconv"
+	"testing"
+	"time"
+)
+
+// decodeConfig is a structure used to test the Decode function.
+type decodeConfig struct {
+	Host     string        `g:"host,default=localhost"`
+	Port     int           `g:"port,required"`
+	Debug    bool          `g:"debug"`
+	Ratio    float32       `g:"ratio,default=0.5"`
+	Timeout  time.Duration `g:"timeout,default=30s"`
+	Since    time.Time     `g:"since,format=%Y-%m-%d"`
+	Tags     []string      `g:"tags,default=a,b,c"`
+	Ports    []uint16      `g:"ports"`
+	Limit    *int          `g:"limit"`
+	Addr     netip.Addr    `g:"addr"`
+	Name     string
+	Ignored  string `g:"-"`
+	internal string
+
+	DB struct {
+		User string `g:"user"`
+		Port int    `g:"port,default=5432"`
+	} `g:"db"`
+
+	Cache *struct {
+		Size int `g:"size"`
+	} `g:"cache"`
+}
+
+// TestDecode tests the Decode function.
+func TestDecode(t *testing.T) {
+	var cfg decodeConfig
+	err := Decode(&cfg, map[string]string{
+		"port":    "8080",
+		"debug":   "yes",
+		"since":   "2023-07-17",
+		"ports":   "80, 443",
+		"limit":   "10",
+		"addr":    "127.0.0.1",
+		"Name":    "goloop",
+		"Ignored": "value",
+		"db.user": "admin",
+	})
+	if err != nil {
+		t.Fatalf("Decode() error = %v", err)
+	}
+
+	if cfg.Host != "localhost" || cfg.Port != 8080 || !cfg.Debug ||
+		cfg.Ratio != 0.5 || cfg.Timeout != 30*time.Second ||
+		cfg.Name != "goloop" || cfg.Ignored != "" {
+		t.Errorf("Decode() = %+v", cfg)
+	}
+
+	if !cfg.Since.Equal(time.Date(2023, 7, 17, 0, 0, 0, 0, time.UTC)) {
+		t.Errorf("Decode() Since = %v", cfg.Since)
+	}
+
+	if !reflect.DeepEqual(cfg.Tags, []string{"a", "b", "c"}) ||
+		!reflect.DeepEqual(cfg.Ports, []uint16{80, 443}) {
+		t.Errorf("Decode() Tags = %v, Ports = %v", cfg.Tags, cfg.Ports)
+	}
+
+	if cfg.Limit == nil || *cfg.Limit != 10 {
+		t.Errorf("Decode() Limit = %v", cfg.Limit)
+	}
+
+	if cfg.Addr.String() != "127.0.0.1" {
+		t.Errorf("Decode() Addr = %v", cfg.Addr)
+	}
+
+	if cfg.DB.User != "admin" || cfg.DB.Port != 5432 {
+		t.Errorf("Decode() DB = %+v", cfg.DB)
+	}
+
+	if cfg.Cache != nil {
+		t.Errorf("Decode() Cache = %+v, want nil", cfg.Cache)
+	}
+}
+
+// TestDecodeErrors tests that the Decode function collects all errors.
+func TestDecodeErrors(t *testing.T) {
+	var cfg decodeConfig
+	err := Decode(&cfg, map[string]string{
+		"debug":      "maybe",
+		"ports":      "80,70000",
+		"db.port":    "abc",
+		"cache.size": "1",
+	})
+
+	var report *DecodeError
+	if !errors.As(err, &report) {
+		t.Fatalf("Decode() error = %v, want *DecodeError", err)
+	}
+
+	fields := make([]string, len(report.Errors))
+	for i, e := range report.Errors {
+		fields[i] = e.Field
+	}
+
+	want := []string{"Port", "Debug", "Ports", "DB.Port"}
+	if !reflect.DeepEqual(fields, want) {
+		t.Errorf("Decode() failed fields = %v, want %v", fields, want)
+	}
+
+	if !errors.Is(err, strconv.ErrRange) {
+		t.Errorf("Decode() error should wrap strconv.ErrRange: %v", err)
+	}
+
+	if cfg.Cache == nil || cfg.Cache.Size != 1 {
+		t.Errorf("Decode() Cache = %+v", cfg.Cache)
+	}
+
+	if err := Decode(cfg, nil); err == nil {
+		t.Errorf("Decode() expected error for a non-pointer")
+	}
+}
+
+// TestDecodeOptions tests the Decode function with options.
+func TestDecodeOptions(t *testing.T) {
+	var cfg struct {
+		Tags []string `g:"tags"`
+		DB   struct {
+			Host string `g:"host"`
+		} `g:"db"`
+	}
+
+	err := Decode(&cfg, map[string]string{
+		"TAGS":     "a; b",
+		"DB__HOST": "db.local",
+	}, DecodeOptions{Separator: ";", Delimiter: "__", UpperCase: true})
+	if err != nil {
+		t.Fatalf("Decode() error = %v", err)
+	}
+
+	if !reflect.DeepEqual(cfg.Tags, []string{"a", "b"}) ||
+		cfg.DB.Host != "db.local" {
+		t.Errorf("Decode() = %+v", cfg)
+	}
+}
+
+// The decodeNode is a recursive type for the Decode tests.
+type decodeNode struct {
+	Name string      `g:"name"`
+	Next *decodeNode `g:"next"`
+}
+
+// TestDecodeRecursive tests the Decode function with a recursive type.
+func TestDecodeRecursive(t *testing.T) {
+	var n decodeNode
+	if err := Decode(&n, map[string]string{"name": "a"}); err != nil {
+		t.Fatalf("Decode() error = %v", err)
+	}
+
+	if n.Name != "a" || n.Next != nil {
+		t.Errorf("Decode() = %+v, want a single node", n)
+	}
+
+	n = decodeNode{}
+	err := Decode(&n, map[string]string{
+		"name":           "a",
+		"next.name":      "b",
+		"next.next.name": "c",
+	})
+	if err != nil {
+		t.Fatalf("Decode() error = %v", err)
+	}
+
+	if n.Next == nil || n.Next.Next == nil || n.Next.Next.Next != nil ||
+		n.Next.Name != "b" || n.Next.Next.Name != "c" {
+		t.Errorf("Decode() = %+v, want a list of three nodes", n)
+	}
+}
+
+// TestDecodeEnv tests the DecodeEnv function.
+func TestDecodeEnv(t *testing.T) {
+	t.Setenv("GTEST_PORT", "9000")
+	t.Setenv("GTEST_DEBUG", "on")
+	t.Setenv("GTEST_DB_USER", "root")
+
+	var cfg decodeConfig
+	if err := DecodeEnv(&cfg, "gtest_"); err != nil {
+		t.Fatalf("DecodeEnv() error = %v", err)
+	}
+
+	if cfg.Port != 9000 || !cfg.Debug || cfg.DB.User != "root" ||
+		cfg.Host != "localhost" {
+		t.Errorf("DecodeEnv() = %+v", cfg)
+	}
+}
@@ -0,0 +1,629 @@
+package g
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"reflect"
+	"strconv"
+	"strings"
+	"time"
+	"unicode/utf8"
+)
+
+// The formatSpec is a parsed format specification of a replacement
+// field of the Format function:
+// [[fill]align][sign][#][0][width][grouping][.precision][type].
+type formatSpec struct {
+	fill      rune // 0 if not set
+	align     byte // '<', '>', '^', '=' or 0
+	sign      byte // '+', '-', ' ' or 0
+	alternate bool
+	zero      bool
+	width     int
+	grouping  byte // ',', '_' or 0
+	precision int  // -1 if not set
+	verb      byte // presentation type or 0
+}
+
+// The isFormatAlign function checks if the byte is an alignment option.
+func isFormatAlign(c byte) bool {
+	return c == '<' || c == '>' || c == '^' || c == '='
+}
+
+// The leadingDigits function returns the length of the prefix
+// of the string that consists of ASCII digits.
+func leadingDigits(s string) int {
+	i := 0
+	for i < len(s) && s[i] >= '0' && s[i] <= '9' {
+		i++
+	}
+
+	return i
+}
+
+// The parseFormatSpec function parses the format specification.
+func parseFormatSpec(spec string) (formatSpec, error) {
+	f := formatSpec{precision: -1}
+	s := spec
+
+	// Fill and align, the fill character can be any rune.
+	if r, size := utf8.DecodeRuneInString(s); size > 0 && size < len(s) &&
+		isFormatAlign(s[size]) {
+		f.fill, f.align, s = r, s[size], s[size+1:]
+	} else if len(s) > 0 && isFormatAlign(s[0]) {
+		f.align, s = s[0], s[1:]
+	}
+
+	if len(s) > 0 && strings.IndexByte("+- ", s[0]) >= 0 {
+		f.sign, s = s[0], s[1:]
+	}
+
+	if len(s) > 0 && s[0] == '#' {
+		f.alternate, s = true, s[1:]
+	}
+
+	if len(s) > 0 && s[0] == '0' {
+		f.zero, s = true, s[1:]
+	}
+
+	if n := leadingDigits(s); n > 0 {
+		width, err := strconv.Atoi(s[:n])
+		if err != nil {
+			return f, fmt.Errorf("width %s is too large", s[:n])
+		}
+		f.width, s = width, s[n:]
+	}
+
+	if len(s) > 0 && (s[0] == ',' || s[0] == '_') {
+		f.grouping, s = s[0], s[1:]
+	}
+
+	if len(s) > 0 && s[0] == '.' {
+		n := leadingDigits(s[1:])
+		if n == 0 {
+			return f, errors.New("format specifier missing precision")
+		}
+
+		precision, err := strconv.Atoi(s[1 : n+1])
+		if err != nil {
+			return f, fmt.Errorf("precision %s is too large", s[1:n+1])
+		}
+		f.precision, s = precision, s[n+1:]
+	}
+
+	if len(s) == 1 && strings.IndexByte("bcdeEfFgGnosxX%", s[0]) >= 0 {
+		f.verb, s = s[0], s[1:]
+	}
+
+	if s != "" {
+		return f, fmt.Errorf("invalid format specifier %q", spec)
+	}
+
+	return f, nil
+}
+
+// The pad method aligns the string within the width in columns,
+// the align is used if the alignment isn't specified.
+func (f formatSpec) pad(s string, align byte) string {
+	var fill []rune
+	if f.fill != 0 {
+		fill = []rune{f.fill}
+	}
+
+	if f.align != 0 {
+		align = f.align
+	}
+
+	switch align {
+	case '>':
+		return PadLeft(s, f.width, fill...)
+	case '^':
+		return Center(s, f.width, fill...)
+	}
+
+	return PadRight(s, f.width, fill...)
+}
+
+// The padNumber method aligns the number within the width in columns.
+// The '=' alignment (the default for the '0' option) places the padding
+// between the sign with the prefix and the digits.
+func (f formatSpec) padNumber(negative bool, prefix, digits string) string {
+	sign := ""
+	switch {
+	case negative:
+		sign = "-"
+	case f.sign == '+' || f.sign == ' ':
+		sign = string(f.sign)
+	}
+
+	if f.zero && f.fill == 0 {
+		f.fill = '0'
+	}
+
+	if f.zero && f.align == 0 {
+		f.align = '='
+	}
+
+	if f.align != '=' {
+		return f.pad(sign+prefix+digits, '>')
+	}
+
+	head := sign + prefix
+	var fill []rune
+	if f.fill != 0 {
+		fill = []rune{f.fill}
+	}
+
+	return head + padding(f.width-DisplayWidth(head+digits), fill) + digits
+}
+
+// The formatInt method formats the integer given by the sign and
+// the magnitude.
+func (f formatSpec) formatInt(negative bool, abs uint64) (string, error) {
+	base := 10
+	switch f.verb {
+	case 'e', 'E', 'f', 'F', 'g', 'G', '%':
+		v := float64(abs)
+		return f.formatFloat(If(negative, -v, v), 64)
+	case 'c':
+		if f.sign != 0 || f.alternate || f.grouping != 0 {
+			return "", errors.New("sign, '#' and grouping " +
+				"are not allowed with format code 'c'")
+		}
+
+		if abs > utf8.MaxRune || negative {
+			return "", errors.New("character code is out of range")
+		}
+
+		return f.pad(string(rune(abs)), '>'), nil
+	case 'b':
+		base = 2
+	case 'o':
+		base = 8
+	case 'x', 'X':
+		base = 16
+	}
+
+	if f.precision >= 0 {
+		return "", errors.New("precision is not allowed for integers")
+	}
+
+	if f.grouping == ',' && base != 10 {
+		return "", fmt.Errorf("cannot specify ',' with '%c'", f.verb)
+	}
+
+	digits := strconv.FormatUint(abs, base)
+	if f.verb == 'X' {
+		digits = strings.ToUpper(digits)
+	}
+
+	if f.grouping != 0 {
+		digits = groupDigits(
+			digits,
+			rune(f.grouping),
+			If(base == 10, 3, 4),
+		)
+	}
+
+	prefix := ""
+	if f.alternate && base != 10 {
+		prefix = "0" + string(f.verb)
+	}
+
+	return f.padNumber(negative, prefix, digits), nil
+}
+
+// The formatFloat method formats the floating-point number,
+// the bits are the size of the number's type (32 or 64).
+func (f formatSpec) formatFloat(v float64, bits int) (string, error) {
+	if f.verb != 0 && strings.IndexByte("eEfFgGn%", f.verb) < 0 {
+		return "", fmt.Errorf(
+			"format code '%c' is not allowed for floats",
+			f.verb,
+		)
+	}
+
+	negative := math.Signbit(v) && !math.IsNaN(v)
+	v = math.Abs(v)
+
+	verb, prec := f.verb, f.precision
+	switch verb {
+	case 0, 'n':
+		verb = 'g'
+	case 'F':
+		verb = 'f'
+	}
+
+	if prec < 0 && f.verb != 0 {
+		prec = 6
+	}
+
+	var digits string
+	switch {
+	case math.IsInf(v, 0):
+		digits = "inf"
+	case math.IsNaN(v):
+		digits = "nan"
+	case verb == '%':
+		digits = strconv.FormatFloat(v*100, 'f', prec, bits)
+	default:
+		digits = strconv.FormatFloat(v, verb, prec, bits)
+	}
+
+	if strings.IndexByte("EFG", f.verb) >= 0 {
+		digits = strings.ToUpper(digits)
+	}
+
+	if f.grouping != 0 {
+		i := leadingDigits(digits)
+		digits = groupDigits(digits[:i], rune(f.grouping)) + digits[i:]
+	}
+
+	if f.verb == '%' {
+		digits += "%"
+	}
+
+	return f.padNumber(negative, "", digits), nil
+}
+
+// The formatValue function formats the value by the format specification.
+//
+// Integers and floats are formatted as numbers, other values are
+// converted to strings by the ToString function; time.Time values
+// with a strftime specification (e.g. "%Y-%m-%d") are formatted
+// as dates.
+func formatValue(v any, spec string) (string, error) {
+	if t, ok := v.(time.Time); ok && strings.Contains(spec, "%") {
+		return t.Format(pythonToGolangFormat(spec)), nil
+	}
+
+	f, err := parseFormatSpec(spec)
+	if err != nil {
+		return "", err
+	}
+
+	// Numbers are formatted as strings with the "s" format code.
+	// Numeric types with the String method, such as enums, are
+	// formatted as numbers only with a numeric format code.
+	_, stringer := v.(fmt.Stringer)
+	numeric := f.verb != 0 && f.verb != 's'
+	asNumber := numeric || f.verb == 0 && !stringer
+
+	rv := reflect.ValueOf(v)
+	switch rv.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32,
+		reflect.Int64:
+		if asNumber {
+			i := rv.Int()
+			return f.formatInt(i < 0, magnitude(i))
+		}
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32,
+		reflect.Uint64, reflect.Uintptr:
+		if asNumber {
+			return f.formatInt(false, rv.Uint())
+		}
+	case reflect.Float32, reflect.Float64:
+		if asNumber {
+			return f.formatFloat(rv.Float(), rv.Type().Bits())
+		}
+	}
+
+	switch {
+	case numeric:
+		return "", fmt.Errorf("format code '%c' is not allowed for %T",
+			f.verb, v)
+	case f.sign != 0:
+		return "", fmt.Errorf("sign is not allowed for %T", v)
+	case f.grouping != 0:
+		return "", fmt.Errorf("grouping is not allowed for %T", v)
+	case f.alternate:
+		return "", fmt.Errorf("'#' is not allowed for %T", v)
+	case f.align == '=':
+		return "", fmt.Errorf("'=' alignment is not allowed for %T", v)
+	}
+
+	s := ToString(v)
+	if f.precision >= 0 {
+		s = Truncate(s, f.precision, "")
+	}
+
+	if f.zero && f.fill == 0 {
+		f.fill = '0'
+	}
+
+	return f.pad(s, '<'), nil
+}
+
+// The formatter holds the state of the Format function.
+type formatter struct {
+	args   []any
+	next   int  // the next automatic field number
+	auto   bool // automatic field numbering is used
+	manual bool // manual field numbering is used
+}
+
+// The member function returns the element of the map, the field of the
+// structure (by name or by the `g` tag) or the element of the slice
+// (by index) for the key. Pointers and interfaces are dereferenced.
+func member(rv reflect.Value, key string) (reflect.Value, bool) {
+	for rv.Kind() == reflect.Pointer || rv.Kind() == reflect.Interface {
+		if rv.IsNil() {
+			return reflect.Value{}, false
+		}
+		rv = rv.Elem()
+	}
+
+	switch rv.Kind() {
+	case reflect.Map:
+		kt := rv.Type().Key()
+		if kt.Kind() == reflect.String {
+			e := rv.MapIndex(reflect.ValueOf(key).Convert(kt))
+			return e, e.IsValid()
+		}
+	case reflect.Struct:
+		t := rv.Type()
+		for i := 0; i < t.NumField(); i++ {
+			field := t.Field(i)
+			if !field.IsExported() {
+				continue
+			}
+
+			if tag := parseFieldTag(field); !tag.skip && tag.name == key {
+				return rv.Field(i), true
+			}
+		}
+
+		// Fields promoted from embedded structures.
+		field, ok := t.FieldByName(key)
+		if ok && len(field.Index) > 1 && field.IsExported() {
+			return rv.FieldByIndex(field.Index), true
+		}
+	case reflect.Slice, reflect.Array:
+		if n := leadingDigits(key); n > 0 && n == len(key) {
+			if i, err := strconv.Atoi(key); err == nil && i < rv.Len() {
+				return rv.Index(i), true
+			}
+		}
+	}
+
+	return reflect.Value{}, false
+}
+
+// The lookup method returns the value of the field name: an argument
+// number or a key of the map or structure arguments, followed by
+// any number of ".name" and "[key]" parts.
+func (f *formatter) lookup(name string) (any, error) {
+	end := strings.IndexAny(name, ".[")
+	if end < 0 {
+		end = len(name)
+	}
+
+	first, rest := name[:end], name[end:]
+
+	var rv reflect.Value
+	switch {
+	case first == "":
+		if f.manual {
+			return nil, errors.New("cannot switch from manual " +
+				"field numbering to automatic field numbering")
+		}
+
+		if f.next >= len(f.args) {
+			return nil, fmt.Errorf("missing positional argument %d", f.next)
+		}
+
+		rv = reflect.ValueOf(&f.args[f.next]).Elem()
+		f.auto = true
+		f.next++
+	case leadingDigits(first) == len(first):
+		if f.auto {
+			return nil, errors.New("cannot switch from automatic " +
+				"field numbering to manual field numbering")
+		}
+
+		i, err := strconv.Atoi(first)
+		if err != nil || i >= len(f.args) {
+			return nil, fmt.Errorf("missing positional argument %s", first)
+		}
+
+		rv = reflect.ValueOf(&f.args[i]).Elem()
+		f.manual = true
+	default:
+		ok := false
+		for _, arg := range f.args {
+			if rv, ok = member(reflect.ValueOf(arg), first); ok {
+				break
+			}
+		}
+
+		if !ok {
+			return nil, fmt.Errorf("missing key %q", first)
+		}
+	}
+
+	path := first
+	for rest != "" {
+		var key string
+		if rest[0] == '.' {
+			end := strings.IndexAny(rest[1:], ".[")
+			if end < 0 {
+				end = len(rest) - 1
+			}
+			key, rest = rest[1:end+1], rest[end+1:]
+		} else {
+			end := strings.IndexByte(rest, ']')
+			if end < 0 {
+				return nil, errors.New("missing ']' in field name")
+			}
+			key, rest = rest[1:end], rest[end+1:]
+			if rest != "" && rest[0] != '.' && rest[0] != '[' {
+				return nil, errors.New("only '.' or '[' may follow ']' " +
+					"in field name")
+			}
+		}
+
+		if key == "" {
+			return nil, fmt.Errorf("empty attribute in field name %q", name)
+		}
+
+		var ok bool
+		if rv, ok = member(rv, key); !ok {
+			return nil, fmt.Errorf("missing key %q in %s", key, path)
+		}
+		path += "." + key
+	}
+
+	if !rv.CanInterface() {
+		return nil, fmt.Errorf("field %s is not accessible", path)
+	}
+
+	return rv.Interface(), nil
+}
+
+// The field method formats the replacement field, i.e. the text
+// between the braces: field_name[!conversion][:format_spec].
+func (f *formatter) field(field string, nested bool) (string, error) {
+	// The end of the field name, brackets can contain any characters.
+	end := 0
+	for end < len(field) && field[end] != ':' && field[end] != '!' {
+		if field[end] == '[' {
+			if i := strings.IndexByte(field[end:], ']'); i > 0 {
+				end += i
+			}
+		}
+		end++
+	}
+
+	name, conversion, spec := field[:end], byte(0), ""
+	if end < len(field) && field[end] == '!' {
+		if end+1 >= len(field) ||
+			end+2 < len(field) && field[end+2] != ':' {
+			return "", errors.New("expected ':' after conversion specifier")
+		}
+		conversion, end = field[end+1], end+2
+	}
+
+	if end < len(field) {
+		spec = field[end+1:]
+	}
+
+	v, err := f.lookup(name)
+	if err != nil {
+		return "", err
+	}
+
+	// Nested replacement fields in the format specification,
+	// e.g. "{value:>{width}}".
+	if strings.ContainsAny(spec, "{}") {
+		if nested {
+			return "", errors.New("max string recursion exceeded")
+		}
+
+		if spec, err = f.format(spec, true); err != nil {
+			return "", err
+		}
+	}
+
+	switch conversion {
+	case 0:
+	case 's':
+		v = ToString(v)
+	case 'r':
+		v = fmt.Sprintf("%#v", v)
+	default:
+		return "", fmt.Errorf("unknown conversion specifier %c", conversion)
+	}
+
+	return formatValue(v, spec)
+}
+
+// The format method replaces the fields in the string,
+// the nested flag is set for format specifications.
+func (f *formatter) format(s string, nested bool) (string, error) {
+	var sb strings.Builder
+	sb.Grow(len(s))
+	for i := 0; i < len(s); {
+		switch {
+		case strings.HasPrefix(s[i:], "{{"), strings.HasPrefix(s[i:], "}}"):
+			sb.WriteByte(s[i])
+			i += 2
+		case s[i] == '}':
+			return "", fmt.Errorf("single '}' at position %d", i)
+		case s[i] == '{':
+			// The matching brace, nested fields are allowed.
+			depth, end := 1, i+1
+			for ; end < len(s) && depth > 0; end++ {
+				switch s[end] {
+				case '{':
+					depth++
+				case '}':
+					depth--
+				}
+			}
+
+			if depth > 0 {
+				return "", fmt.Errorf("unclosed '{' at position %d", i)
+			}
+
+			field := s[i+1 : end-1]
+			out, err := f.field(field, nested)
+			if err != nil {
+				return "", fmt.Errorf("field {%s}: %w", field, err)
+			}
+
+			sb.WriteString(out)
+			i = end
+		default:
+			end := strings.IndexAny(s[i:], "{}")
+			if end < 0 {
+				end = len(s) - i
+			}
+
+			sb.WriteString(s[i : i+end])
+			i += end
+		}
+	}
+
+	return sb.String(), nil
+}
+
+// Format formats the string in the style of Python's str.format:
+// replacement fields in braces are substituted by the arguments.
+//
+// A field is {name!conversion:spec}, where all parts are optional:
+//
+//   - name is an argument number ("{0}") or a key of the map and
+//     structure arguments ("{name}"), empty names take the arguments
+//     in order ("{}"); the name can be followed by ".field" and
+//     "[key]" parts, e.g. "{user.name}" or "{items[0]}". Structure
+//     fields are matched by their names or `g` tags;
+//   - conversion is "!s" (ToString) or "!r" (Go-syntax representation);
+//   - spec is [[fill]align][sign][#][0][width][grouping][.precision][type],
+//     where align is "<", ">", "^" or "=" (padding after the sign),
+//     sign is "+", "-" or " ", "#" adds the 0b/0o/0x prefix, "0"
+//     pads numbers with zeros, grouping is "," or "_", and type is one
+//     of "s", "d", "b", "o", "x", "X", "c", "e", "E", "f", "F", "g",
+//     "G", "n" or "%". For strings, the precision is the maximum width.
+//     Specs can contain nested fields, e.g. "{value:>{width}}".
+//     The time.Time values accept strftime specs, e.g. "{date:%Y-%m-%d}".
+//
+// The width is measured in columns, see the DisplayWidth function.
+// Use "{{" and "}}" for literal braces. Errors describe the field
+// and the reason, e.g. a missing key or an invalid format code.
+//
+// Example usage:
+//
+//	args := map[string]any{"name": "Alice", "age": 30, "amount": 1234.5}
+//	g.Format("{name} is {age:>5} and owes {amount:,.2f}", args)
+//	// Output: "Alice is    30 and owes 1,234.50", nil
+//
+//	g.Format("{0:*^9} {1:08.3f} {2:#x}", "hi", 3.14159, 255)
+//	// Output: "***hi**** 0003.142 0xff", nil
+//
+//	g.Format("{email}", args)
+//	// Output: "", field {email}: missing key "email"
+func Format(format string, args ...any) (string, error) {
+	f := &formatter{args: args}
+	return f.format(format, false)
+}
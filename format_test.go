@@ -0,0 +1,193 @@
+package g
+
+import (
+	"math"
+	"strings"
+	"testing"
+	"time"
+)
+
+// TestFormat tests the Format function.
+func TestFormat(t *testing.T) {
+	type address struct {
+		City string `g:"city"`
+	}
+
+	type user struct {
+		Name    string `g:"name"`
+		Age     int
+		Balance float64 `g:"balance"`
+		Address address `g:"address"`
+		Tags    []string
+		secret  string
+	}
+
+	u := user{
+		Name:    "Alice",
+		Age:     30,
+		Balance: -1234.5,
+		Address: address{City: "Kyiv"},
+		Tags:    []string{"admin", "dev"},
+		secret:  "x",
+	}
+	m := map[string]any{"name": "Bob", "age": 42, "amount": 1234567.891}
+	date := time.Date(2024, 3, 8, 14, 5, 0, 0, time.UTC)
+
+	tests := []struct {
+		name   string
+		format string
+		args   []any
+		want   string
+	}{
+		{"No fields", "Hello, World", nil, "Hello, World"},
+		{"Escaped braces", "{{x}} {}", []any{1}, "{x} 1"},
+		{"Automatic", "{} + {} = {}", []any{2, 3, 5}, "2 + 3 = 5"},
+		{"Positional", "{1} {0} {1}", []any{"a", "b"}, "b a b"},
+		{"Map", "{name} is {age}", []any{m}, "Bob is 42"},
+		{"Struct by tag", "{name}, {Age}", []any{u}, "Alice, 30"},
+		{"Struct pointer", "{name}", []any{&u}, "Alice"},
+		{"Nested field", "{address.city}", []any{u}, "Kyiv"},
+		{"Index", "{Tags[1]} {0.Tags[0]}", []any{u}, "dev admin"},
+		{"Map key", "{0[name]}", []any{m}, "Bob"},
+		{"Several sources", "{name} {amount:.0f}", []any{u, m}, "Alice 1234568"},
+		{"Request example", "{name} is {age:>5} and owes {amount:.2f}",
+			[]any{m}, "Bob is    42 and owes 1234567.89"},
+
+		// Strings.
+		{"Left", "[{:<6}]", []any{"ab"}, "[ab    ]"},
+		{"Right", "[{:>6}]", []any{"ab"}, "[    ab]"},
+		{"Center", "[{:^7}]", []any{"ab"}, "[  ab   ]"},
+		{"Fill", "[{:*^6}]", []any{"ab"}, "[**ab**]"},
+		{"Unicode fill", "[{:─<5}]", []any{"ab"}, "[ab───]"},
+		{"Default left", "[{:5}]", []any{"ab"}, "[ab   ]"},
+		{"Truncate", "[{:.3}]", []any{"abcdef"}, "[abc]"},
+		{"Truncate and pad", "[{:>5.3}]", []any{"abcdef"}, "[  abc]"},
+		{"Wide", "[{:>6}]", []any{"你好"}, "[  你好]"},
+		{"Cyrillic", "[{:<8}]", []any{"Привіт"}, "[Привіт  ]"},
+		{"Bool", "{} {:>6}", []any{true, false}, "true  false"},
+		{"Nil", "{}", []any{nil}, "<nil>"},
+		{"Number as string", "[{:s}]", []any{42}, "[42]"},
+
+		// Integers.
+		{"Int right", "[{:5}]", []any{42}, "[   42]"},
+		{"Int left", "[{:<5d}]", []any{42}, "[42   ]"},
+		{"Int zero", "{:05}", []any{-42}, "-0042"},
+		{"Int sign", "{:+} {:+} {: }", []any{5, -5, 5}, "+5 -5  5"},
+		{"Int align after sign", "[{:=+6}]", []any{42}, "[+   42]"},
+		{"Int grouping", "{:,}", []any{1234567}, "1,234,567"},
+		{"Int underscore", "{:_}", []any{-1234567}, "-1_234_567"},
+		{"Binary", "{:b} {:#b}", []any{5, 5}, "101 0b101"},
+		{"Octal", "{:#o}", []any{8}, "0o10"},
+		{"Hex", "{:x} {:X} {:#x}", []any{255, 255, 255}, "ff FF 0xff"},
+		{"Hex grouping", "{:_x}", []any{0xdeadbeef}, "dead_beef"},
+		{"Hex zero", "{:#06x}", []any{255}, "0x00ff"},
+		{"Char", "{:c}{:c}", []any{'G', 0x43e}, "Gо"},
+		{"Uint", "{:,}", []any{uint64(math.MaxUint64)},
+			"18,446,744,073,709,551,615"},
+		{"Min int", "{}", []any{math.MinInt64}, "-9223372036854775808"},
+		{"Int as float", "{:.2f}", []any{7}, "7.00"},
+
+		// Floats.
+		{"Float", "{}", []any{3.14}, "3.14"},
+		{"Float32", "{}", []any{float32(0.1)}, "0.1"},
+		{"Fixed", "{:.2f}", []any{3.14159}, "3.14"},
+		{"Fixed default", "{:f}", []any{1.5}, "1.500000"},
+		{"Fixed grouping", "{:,.2f}", []any{-1234567.891}, "-1,234,567.89"},
+		{"Fixed width", "[{:10.3f}]", []any{3.14159}, "[     3.142]"},
+		{"Fixed zero", "{:08.3f}", []any{-3.14159}, "-003.142"},
+		{"Exponent", "{:e} {:.2E}", []any{1234.5, 1234.5},
+			"1.234500e+03 1.23E+03"},
+		{"General", "{:g} {:.3g}", []any{1234.5, 1234.5}, "1234.5 1.23e+03"},
+		{"Precision", "{:.3}", []any{3.14159}, "3.14"},
+		{"Percent", "{:.1%}", []any{0.256}, "25.6%"},
+		{"Inf", "{:f} {:F} {:+}", []any{math.Inf(1), math.Inf(1),
+			math.Inf(-1)}, "inf INF -inf"},
+		{"NaN", "{:.2f}", []any{math.NaN()}, "nan"},
+
+		// Nested fields.
+		{"Nested width", "[{:>{}}]", []any{"ab", 5}, "[   ab]"},
+		{"Nested named", "[{name:{fill}^{width}}]", []any{map[string]any{
+			"name": "ab", "fill": "*", "width": 6}}, "[**ab**]"},
+
+		// Conversions.
+		{"Conversion r", "{!r}", []any{"ab"}, `"ab"`},
+		{"Conversion s", "{!s:>4}", []any{12}, "  12"},
+
+		// Dates.
+		{"Date", "{:%Y-%m-%d}", []any{date}, "2024-03-08"},
+		{"Date time", "{d:%d.%m.%Y %H:%M}", []any{map[string]any{"d": date}},
+			"08.03.2024 14:05"},
+		{"Date default", "{}", []any{date}, "2024-03-08T14:05:00Z"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := Format(tt.format, tt.args...)
+			if err != nil {
+				t.Fatalf("Format() error = %v", err)
+			}
+
+			if got != tt.want {
+				t.Errorf("Format() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+// TestFormatErrors tests the errors of the Format function.
+func TestFormatErrors(t *testing.T) {
+	type user struct {
+		Name   string `g:"name"`
+		secret string
+		Skip   string `g:"-"`
+	}
+
+	u := user{Name: "Alice", secret: "x", Skip: "y"}
+	tests := []struct {
+		name   string
+		format string
+		args   []any
+		want   string
+	}{
+		{"Missing key", "{name} {email}", []any{u},
+			`field {email}: missing key "email"`},
+		{"Missing map key", "{age}", []any{map[string]int{"x": 1}},
+			`field {age}: missing key "age"`},
+		{"Missing nested key", "{user.email}",
+			[]any{map[string]any{"user": u}},
+			`field {user.email}: missing key "email" in user`},
+		{"Unexported field", "{secret}", []any{u}, `missing key "secret"`},
+		{"Skipped field", "{Skip}", []any{u}, `missing key "Skip"`},
+		{"Missing argument", "{} {}", []any{1}, "missing positional argument 1"},
+		{"Missing index", "{3}", []any{1}, "missing positional argument 3"},
+		{"Index out of range", "{0[5]}", []any{[]int{1}}, `missing key "5"`},
+		{"Auto to manual", "{} {1}", []any{1, 2}, "cannot switch"},
+		{"Manual to auto", "{0} {}", []any{1, 2}, "cannot switch"},
+		{"Unclosed", "abc {name", []any{u}, "unclosed '{' at position 4"},
+		{"Single brace", "abc }", nil, "single '}' at position 4"},
+		{"Missing bracket", "{0[1}", []any{[]int{1}}, "missing ']'"},
+		{"Invalid spec", "{:>5z}", []any{1}, "invalid format specifier"},
+		{"Missing precision", "{:.f}", []any{1.5}, "missing precision"},
+		{"String code for int", "{:d}", []any{"ab"},
+			"format code 'd' is not allowed for string"},
+		{"Code for float", "{:x}", []any{1.5}, "format code 'x'"},
+		{"Sign for string", "{:+}", []any{"ab"}, "sign is not allowed"},
+		{"Precision for int", "{:.2d}", []any{1}, "precision is not allowed"},
+		{"Comma for hex", "{:,x}", []any{255}, "cannot specify ','"},
+		{"Conversion", "{!x}", []any{1}, "unknown conversion"},
+		{"Deep nesting", "{:{:{}}}", []any{1, 2, 3}, "recursion"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := Format(tt.format, tt.args...)
+			if err == nil {
+				t.Fatalf("Format() error = nil, want %q", tt.want)
+			}
+
+			if !strings.Contains(err.Error(), tt.want) {
+				t.Errorf("Format() error = %q, want %q", err, tt.want)
+			}
+		})
+	}
+}
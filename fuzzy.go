@@ -0,0 +1,342 @@
+package g
+
+import "sort"
+
+// Levenshtein returns the Levenshtein distance between two strings,
+// i.e. the minimum number of single-character insertions, deletions
+// and substitutions required to change one string into the other.
+//
+// Strings are compared by runes, so "ї" is one character.
+//
+// Example usage:
+//
+//	g.Levenshtein("kitten", "sitting")  // Output: 3
+//	g.Levenshtein("київ", "киів")       // Output: 1
+func Levenshtein(a, b string) int {
+	ra, rb := []rune(a), []rune(b)
+	if len(ra) < len(rb) {
+		ra, rb = rb, ra
+	}
+
+	// Only two rows of the matrix are needed.
+	prev := make([]int, len(rb)+1)
+	curr := make([]int, len(rb)+1)
+	for j := range prev {
+		prev[j] = j
+	}
+
+	for i := 1; i <= len(ra); i++ {
+		curr[0] = i
+		for j := 1; j <= len(rb); j++ {
+			cost := If(ra[i-1] == rb[j-1], 0, 1)
+			curr[j] = minOf(prev[j]+1, curr[j-1]+1, prev[j-1]+cost)
+		}
+		prev, curr = curr, prev
+	}
+
+	return prev[len(rb)]
+}
+
+// DamerauLevenshtein returns the Damerau–Levenshtein distance between
+// two strings. Unlike the Levenshtein distance, the transposition of two
+// adjacent characters counts as a single edit, which makes it a better
+// measure for typos.
+//
+// Strings are compared by runes.
+//
+// Example usage:
+//
+//	g.DamerauLevenshtein("ca", "ac")     // Output: 1
+//	g.DamerauLevenshtein("ca", "abc")    // Output: 2
+//	g.Levenshtein("ca", "abc")           // Output: 3
+func DamerauLevenshtein(a, b string) int {
+	ra, rb := []rune(a), []rune(b)
+	la, lb := len(ra), len(rb)
+	inf := la + lb
+
+	// The matrix is shifted by one row and column
+	// to hold the "infinity" border.
+	d := make([][]int, la+2)
+	for i := range d {
+		d[i] = make([]int, lb+2)
+	}
+
+	d[0][0] = inf
+	for i := 0; i <= la; i++ {
+		d[i+1][0], d[i+1][1] = inf, i
+	}
+	for j := 0; j <= lb; j++ {
+		d[0][j+1], d[1][j+1] = inf, j
+	}
+
+	// The last row where each character was seen in a.
+	lastRow := make(map[rune]int)
+	for i := 1; i <= la; i++ {
+		lastCol := 0
+		for j := 1; j <= lb; j++ {
+			i1, j1 := lastRow[rb[j-1]], lastCol
+			cost := 1
+			if ra[i-1] == rb[j-1] {
+				cost, lastCol = 0, j
+			}
+
+			d[i+1][j+1] = minOf(
+				d[i][j]+cost,
+				d[i+1][j]+1,
+				d[i][j+1]+1,
+				d[i1][j1]+(i-i1-1)+1+(j-j1-1),
+			)
+		}
+		lastRow[ra[i-1]] = i
+	}
+
+	return d[la+1][lb+1]
+}
+
+// JaroWinkler returns the Jaro–Winkler similarity of two strings,
+// from 0 (no similarity) to 1 (equal strings). The metric favours
+// strings with a common prefix, so it works well for short strings
+// such as names and commands.
+//
+// Strings are compared by runes.
+//
+// Example usage:
+//
+//	g.JaroWinkler("martha", "marhta")  // Output: 0.961...
+//	g.JaroWinkler("abc", "xyz")        // Output: 0
+func JaroWinkler(a, b string) float64 {
+	ra, rb := []rune(a), []rune(b)
+	la, lb := len(ra), len(rb)
+	if la == 0 && lb == 0 {
+		return 1
+	} else if la == 0 || lb == 0 {
+		return 0
+	}
+
+	window := If(la > lb, la, lb)/2 - 1
+	if window < 0 {
+		window = 0
+	}
+
+	matchedA, matchedB := make([]bool, la), make([]bool, lb)
+	matches := 0
+	for i := 0; i < la; i++ {
+		lo, hi := If(i > window, i-window, 0), If(i+window < lb, i+window+1, lb)
+		for j := lo; j < hi; j++ {
+			if !matchedB[j] && ra[i] == rb[j] {
+				matchedA[i], matchedB[j] = true, true
+				matches++
+				break
+			}
+		}
+	}
+
+	if matches == 0 {
+		return 0
+	}
+
+	// Half of the matched characters that are in a different order.
+	transpositions, k := 0, 0
+	for i := 0; i < la; i++ {
+		if !matchedA[i] {
+			continue
+		}
+
+		for !matchedB[k] {
+			k++
+		}
+
+		if ra[i] != rb[k] {
+			transpositions++
+		}
+		k++
+	}
+
+	m := float64(matches)
+	jaro := (m/float64(la) + m/float64(lb) +
+		(m-float64(transpositions)/2)/m) / 3
+
+	// The Winkler's bonus for the common prefix up to 4 characters.
+	prefix := 0
+	for prefix < 4 && prefix < la && prefix < lb && ra[prefix] == rb[prefix] {
+		prefix++
+	}
+
+	return jaro + float64(prefix)*0.1*(1-jaro)
+}
+
+// Jaccard returns the Jaccard similarity of the sets of n-grams of two
+// strings, from 0 (no common n-grams) to 1 (equal sets). By default,
+// bigrams are used (n = 2). A string shorter than n is a single n-gram.
+//
+// The metric doesn't depend on the order of n-grams, so it works well
+// for longer strings with reordered words.
+//
+// Strings are compared by runes.
+//
+// Example usage:
+//
+//	g.Jaccard("night", "nacht")     // Output: 0.142... (1 of 7 bigrams)
+//	g.Jaccard("abc", "abc")         // Output: 1
+//	g.Jaccard("abcd", "abce", 3)    // Output: 0.333... (1 of 3 trigrams)
+func Jaccard(a, b string, n ...int) float64 {
+	size := 2
+	if len(n) > 0 && n[0] > 0 {
+		size = n[0]
+	}
+
+	ga, gb := nGrams(a, size), nGrams(b, size)
+	if len(ga) == 0 && len(gb) == 0 {
+		return 1
+	}
+
+	common := 0
+	for gram := range ga {
+		if _, ok := gb[gram]; ok {
+			common++
+		}
+	}
+
+	return float64(common) / float64(len(ga)+len(gb)-common)
+}
+
+// The nGrams function returns the set of n-grams of the string.
+func nGrams(s string, n int) map[string]struct{} {
+	rs := []rune(s)
+	grams := make(map[string]struct{})
+	if len(rs) > 0 && len(rs) < n {
+		grams[s] = struct{}{}
+	}
+
+	for i := 0; i+n <= len(rs); i++ {
+		grams[string(rs[i:i+n])] = struct{}{}
+	}
+
+	return grams
+}
+
+// The minOf function returns the minimum of the integers.
+func minOf(v int, vs ...int) int {
+	for _, x := range vs {
+		if x < v {
+			v = x
+		}
+	}
+
+	return v
+}
+
+// The similarityScores function scores each element of the list against
+// the value by the metric, the JaroWinkler function is used if the metric
+// is nil. Large lists are scored concurrently.
+func similarityScores(
+	v string,
+	list []string,
+	metric []func(a, b string) float64,
+) []float64 {
+	score := JaroWinkler
+	if len(metric) > 0 && metric[0] != nil {
+		score = metric[0]
+	}
+
+	scores := make([]float64, len(list))
+	doInChunks(len(list), func(start, end int) {
+		for i := start; i < end; i++ {
+			scores[i] = score(v, list[i])
+		}
+	})
+
+	return scores
+}
+
+// FuzzyIndex returns the index of the element of the list that is the
+// most similar to the value, if its similarity is at least the threshold,
+// or -1 otherwise. If several elements are equally similar, the first
+// of them is returned.
+//
+// The similarity is measured by the JaroWinkler function, another metric
+// returning values from 0 to 1 can be passed as the optional argument,
+// e.g. func(a, b string) float64 { return g.Jaccard(a, b, 3) }.
+// Large lists are scored concurrently according to the package's
+// parallel settings.
+//
+// Example usage:
+//
+//	commands := []string{"build", "test", "install"}
+//	g.FuzzyIndex("tset", commands, 0.8)   // Output: 1
+//	g.FuzzyIndex("deploy", commands, 0.8) // Output: -1
+func FuzzyIndex(
+	v string,
+	list []string,
+	threshold float64,
+	metric ...func(a, b string) float64,
+) int {
+	index := -1
+	best := threshold
+	for i, score := range similarityScores(v, list, metric) {
+		if score > best || index < 0 && score >= best {
+			index, best = i, score
+		}
+	}
+
+	return index
+}
+
+// FuzzyIn checks if the list contains an element that is similar to
+// the value at least by the threshold. The similarity is measured
+// as in the FuzzyIndex function.
+//
+// Example usage:
+//
+//	g.FuzzyIn("colour", []string{"color", "size"}, 0.9)  // Output: true
+//	g.FuzzyIn("weight", []string{"color", "size"}, 0.9)  // Output: false
+func FuzzyIn(
+	v string,
+	list []string,
+	threshold float64,
+	metric ...func(a, b string) float64,
+) bool {
+	return FuzzyIndex(v, list, threshold, metric...) >= 0
+}
+
+// BestMatches returns up to n elements of the list that are the most
+// similar to the value, in descending order of similarity; equally
+// similar elements keep their order in the list. Elements with zero
+// similarity are not returned. The similarity is measured as in the
+// FuzzyIndex function.
+//
+// It's useful for "did you mean" suggestions in error messages.
+//
+// Example usage:
+//
+//	commands := []string{"build", "bundle", "test", "install"}
+//	g.BestMatches("biuld", commands, 2)  // Output: [build bundle]
+func BestMatches(
+	v string,
+	list []string,
+	n int,
+	metric ...func(a, b string) float64,
+) []string {
+	scores := similarityScores(v, list, metric)
+	indexes := make([]int, 0, len(list))
+	for i, score := range scores {
+		if score > 0 {
+			indexes = append(indexes, i)
+		}
+	}
+
+	sort.SliceStable(indexes, func(i, j int) bool {
+		return scores[indexes[i]] > scores[indexes[j]]
+	})
+
+	if n < len(indexes) {
+		indexes = indexes[:If(n > 0, n, 0)]
+	}
+
+	result := make([]string, len(indexes))
+	for i, index := range indexes {
+		result[i] = list[index]
+	}
+
+	return result
+}
@@ -0,0 +1,192 @@
+package g
+
+import (
+	"fmt"
+	"math"
+	"reflect"
+	"testing"
+)
+
+// TestLevenshtein tests the Levenshtein function.
+func TestLevenshtein(t *testing.T) {
+	tests := []struct {
+		a, b string
+		want int
+	}{
+		{"", "", 0},
+		{"abc", "", 3},
+		{"", "abc", 3},
+		{"kitten", "sitting", 3},
+		{"flaw", "lawn", 2},
+		{"ca", "abc", 3},
+		{"київ", "киів", 1},
+		{"привіт", "привет", 1},
+		{"go", "go", 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.a+"/"+tt.b, func(t *testing.T) {
+			if got := Levenshtein(tt.a, tt.b); got != tt.want {
+				t.Errorf("Levenshtein() = %d, want %d", got, tt.want)
+			}
+		})
+	}
+}
+
+// TestDamerauLevenshtein tests the DamerauLevenshtein function.
+func TestDamerauLevenshtein(t *testing.T) {
+	tests := []struct {
+		a, b string
+		want int
+	}{
+		{"", "", 0},
+		{"abc", "", 3},
+		{"ca", "ac", 1},
+		{"ca", "abc", 2},
+		{"kitten", "sitting", 3},
+		{"test", "tset", 1},
+		{"їжак", "жїак", 1},
+		{"abcdef", "badcfe", 3},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.a+"/"+tt.b, func(t *testing.T) {
+			if got := DamerauLevenshtein(tt.a, tt.b); got != tt.want {
+				t.Errorf("DamerauLevenshtein() = %d, want %d", got, tt.want)
+			}
+		})
+	}
+}
+
+// TestJaroWinkler tests the JaroWinkler function.
+func TestJaroWinkler(t *testing.T) {
+	tests := []struct {
+		a, b string
+		want float64
+	}{
+		{"", "", 1},
+		{"abc", "", 0},
+		{"abc", "abc", 1},
+		{"abc", "xyz", 0},
+		{"martha", "marhta", 0.961111},
+		{"dwayne", "duane", 0.84},
+		{"dixon", "dicksonx", 0.813333},
+		{"ї", "ї", 1},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.a+"/"+tt.b, func(t *testing.T) {
+			got := JaroWinkler(tt.a, tt.b)
+			if math.Abs(got-tt.want) > 1e-6 {
+				t.Errorf("JaroWinkler() = %f, want %f", got, tt.want)
+			}
+		})
+	}
+}
+
+// TestJaccard tests the Jaccard function.
+func TestJaccard(t *testing.T) {
+	tests := []struct {
+		a, b string
+		n    []int
+		want float64
+	}{
+		{"", "", nil, 1},
+		{"abc", "", nil, 0},
+		{"abc", "abc", nil, 1},
+		{"night", "nacht", nil, 1.0 / 7},
+		{"abcd", "abce", []int{3}, 1.0 / 3},
+		{"a", "a", []int{3}, 1},
+		{"ab", "ba", []int{1}, 1},
+		{"київ", "київ", nil, 1},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.a+"/"+tt.b, func(t *testing.T) {
+			got := Jaccard(tt.a, tt.b, tt.n...)
+			if math.Abs(got-tt.want) > 1e-9 {
+				t.Errorf("Jaccard() = %f, want %f", got, tt.want)
+			}
+		})
+	}
+}
+
+// TestFuzzyIndex tests the FuzzyIndex and FuzzyIn functions.
+func TestFuzzyIndex(t *testing.T) {
+	commands := []string{"build", "test", "install", "tests"}
+	if got := FuzzyIndex("tset", commands, 0.8); got != 1 {
+		t.Errorf("FuzzyIndex(\"tset\") = %d, want 1", got)
+	}
+
+	if got := FuzzyIndex("deploy", commands, 0.8); got != -1 {
+		t.Errorf("FuzzyIndex(\"deploy\") = %d, want -1", got)
+	}
+
+	if got := FuzzyIndex("tests", commands, 1); got != 3 {
+		t.Errorf("FuzzyIndex(\"tests\") = %d, want 3", got)
+	}
+
+	if got := FuzzyIndex("x", nil, 0); got != -1 {
+		t.Errorf("FuzzyIndex() on empty list = %d, want -1", got)
+	}
+
+	trigrams := func(a, b string) float64 { return Jaccard(a, b, 3) }
+	if got := FuzzyIndex("instal", commands, 0.7, trigrams); got != 2 {
+		t.Errorf("FuzzyIndex(\"instal\", Jaccard) = %d, want 2", got)
+	}
+
+	if !FuzzyIn("colour", []string{"color", "size"}, 0.9) {
+		t.Errorf("FuzzyIn(\"colour\") = false, want true")
+	}
+
+	if FuzzyIn("weight", []string{"color", "size"}, 0.9) {
+		t.Errorf("FuzzyIn(\"weight\") = true, want false")
+	}
+}
+
+// TestBestMatches tests the BestMatches function.
+func TestBestMatches(t *testing.T) {
+	commands := []string{"build", "bundle", "test", "install"}
+	tests := []struct {
+		v    string
+		n    int
+		want []string
+	}{
+		{"biuld", 2, []string{"build", "bundle"}},
+		{"biuld", 1, []string{"build"}},
+		{"biuld", 0, []string{}},
+		{"tst", 1, []string{"test"}},
+		{"zzz", 3, []string{}},
+	}
+
+	for _, tt := range tests {
+		t.Run(fmt.Sprintf("%s/%d", tt.v, tt.n), func(t *testing.T) {
+			got := BestMatches(tt.v, commands, tt.n)
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("BestMatches() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+// TestBestMatchesParallel tests the BestMatches and FuzzyIndex functions
+// on a list large enough to be scored concurrently.
+func TestBestMatchesParallel(t *testing.T) {
+	defer ParallelTasks(ParallelTasks())
+	ParallelTasks(4)
+
+	list := make([]string, 4*minLoadPerGoroutine+3)
+	for i := range list {
+		list[i] = fmt.Sprintf("item%d", i)
+	}
+	list[len(list)-2] = "target"
+
+	if got := BestMatches("targer", list, 1); !reflect.DeepEqual(got,
+		[]string{"target"}) {
+		t.Errorf("BestMatches() = %v, want [target]", got)
+	}
+
+	if got := FuzzyIndex("target", list, 0.9); got != len(list)-2 {
+		t.Errorf("FuzzyIndex() = %d, want %d", got, len(list)-2)
+	}
+}
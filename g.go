@@ -3,6 +3,7 @@ package g
 import (
 	"math/rand"
 	"runtime"
+	"sync"
 	"time"
 )
 
@@ -71,3 +72,41 @@ func ParallelTasks(v ...int) int {
 
 	return parallelTasks
 }
+
+// The doInChunks function splits the range [0, n) into chunks and
+// calls fn for each chunk. If the range is large enough (see the
+// minLoadPerGoroutine), chunks are processed concurrently using
+// up to parallelTasks goroutines, otherwise fn is called once
+// for the whole range in the current goroutine.
+//
+// The function returns only after all chunks have been processed.
+// The fn must only write to the part of the data that belongs
+// to its own chunk.
+func doInChunks(n int, fn func(start, end int)) {
+	p := parallelTasks
+	if n <= 0 {
+		return
+	} else if n/p < minLoadPerGoroutine {
+		fn(0, n)
+		return
+	}
+
+	var wg sync.WaitGroup
+	chunkSize := n / p
+	for i := 0; i < p; i++ {
+		wg.Add(1)
+
+		start := i * chunkSize
+		end := start + chunkSize
+		if i == p-1 {
+			end = n
+		}
+
+		go func(start, end int) {
+			defer wg.Done()
+			fn(start, end)
+		}(start, end)
+	}
+
+	wg.Wait()
+}
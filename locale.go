@@ -0,0 +1,411 @@
+package g
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+	"sync"
+	"unicode"
+	"unicode/utf8"
+)
+
+// Locale describes how numbers are written in a particular language
+// or region: which characters separate the groups of digits and the
+// fractional part.
+//
+// Built-in locales are "en", "uk", "de", "fr" and "ru", custom locales
+// can be added with the RegisterLocale function.
+type Locale struct {
+	// Name is the name of the locale, e.g. "en" or "uk".
+	Name string
+
+	// Decimal is the decimal separator, e.g. '.' or ','.
+	Decimal rune
+
+	// Group is the separator of groups of thousands, e.g. ',' or '.'.
+	// If it is a space character (like the no-break space), any space
+	// character is accepted as the group separator when parsing.
+	Group rune
+}
+
+// SignPlacement defines how the sign of a number is written
+// by the FormatNumber function.
+type SignPlacement int
+
+const (
+	// SignDefault writes the minus sign before negative numbers only.
+	SignDefault SignPlacement = iota
+
+	// SignAlways writes the plus or minus sign before any number
+	// except zero.
+	SignAlways
+
+	// SignTrailing writes the minus sign after negative numbers,
+	// as in accounting, e.g. "1,234-".
+	SignTrailing
+
+	// SignParentheses encloses negative numbers in parentheses,
+	// as in accounting, e.g. "(1,234)".
+	SignParentheses
+)
+
+// ExponentMode defines when the FormatNumber function uses
+// the scientific notation.
+type ExponentMode int
+
+const (
+	// ExponentNever always writes numbers without an exponent.
+	ExponentNever ExponentMode = iota
+
+	// ExponentAlways always writes numbers in scientific notation,
+	// e.g. "1.5e6".
+	ExponentAlways
+
+	// ExponentAuto writes very large and very small numbers in
+	// scientific notation: if the decimal exponent is less than -4
+	// or greater than or equal to 21.
+	ExponentAuto
+)
+
+// NumberFormat is a set of options for the FormatNumber function.
+// The zero value formats numbers in the "en" locale with grouping,
+// in the shortest representation and without an exponent.
+type NumberFormat struct {
+	// Locale is the name of a registered locale,
+	// the "en" locale is used if it's empty or unknown.
+	Locale string
+
+	// Fixed enables the fixed precision: exactly Precision digits
+	// are written after the decimal separator. Otherwise, the shortest
+	// representation that restores the value is used.
+	Fixed bool
+
+	// Precision is the number of digits after the decimal separator
+	// if Fixed is true.
+	Precision int
+
+	// TrimZeros removes trailing zeros of the fractional part,
+	// and the decimal separator if nothing is left after it.
+	TrimZeros bool
+
+	// NoGrouping disables the separation of groups of thousands.
+	NoGrouping bool
+
+	// Sign defines how the sign of the number is written.
+	Sign SignPlacement
+
+	// Exponent defines when the scientific notation is used.
+	Exponent ExponentMode
+}
+
+var (
+	// The locales is a registry of locales used by the ParseNumber
+	// and FormatNumber functions.
+	locales = map[string]Locale{
+		"en": {Name: "en", Decimal: '.', Group: ','},
+		"uk": {Name: "uk", Decimal: ',', Group: '\u00a0'}, // no-break space
+		"de": {Name: "de", Decimal: ',', Group: '.'},
+		"fr": {Name: "fr", Decimal: ',', Group: '\u202f'}, // narrow nbsp
+		"ru": {Name: "ru", Decimal: ',', Group: '\u00a0'}, // no-break space
+	}
+
+	// The localesMutex protects the locales registry.
+	localesMutex sync.RWMutex
+)
+
+// RegisterLocale adds a new locale to the registry or replaces
+// an existing locale with the same name. The name is case-insensitive.
+//
+// The function returns an error if the name is empty or the decimal
+// and group separators are the same or not set.
+//
+// Example usage:
+//
+//	// Swiss style: 1'234.56
+//	err := g.RegisterLocale(g.Locale{Name: "ch", Decimal: '.', Group: '\''})
+func RegisterLocale(l Locale) error {
+	if l.Name == "" {
+		return errors.New("locale name is empty")
+	} else if l.Decimal == 0 || l.Group == 0 || l.Decimal == l.Group {
+		return fmt.Errorf("invalid separators for locale %q", l.Name)
+	}
+
+	localesMutex.Lock()
+	defer localesMutex.Unlock()
+
+	l.Name = strings.ToLower(l.Name)
+	locales[l.Name] = l
+	return nil
+}
+
+// LookupLocale returns the registered locale by its name.
+//
+// The name is case-insensitive. If there is no locale with the full
+// name, like "en-US" or "uk_UA", the language part of the name
+// is used ("en", "uk").
+//
+// Example usage:
+//
+//	l, ok := g.LookupLocale("de-AT")  // the "de" locale, true
+func LookupLocale(name string) (Locale, bool) {
+	localesMutex.RLock()
+	defer localesMutex.RUnlock()
+
+	name = strings.ToLower(name)
+	if l, ok := locales[name]; ok {
+		return l, true
+	}
+
+	if i := strings.IndexAny(name, "-_"); i > 0 {
+		l, ok := locales[name[:i]]
+		return l, ok
+	}
+
+	return Locale{}, false
+}
+
+// ParseNumber converts a string with a number written according to the
+// locale to a float64. The locale is the name of a registered locale.
+//
+// The function accepts group separators in the integer part (the first
+// group has 1-3 digits, the next ones exactly 3), the locale's decimal
+// separator and an optional exponent. A negative number can be written
+// with a leading minus (hyphen or the Unicode minus sign), a trailing
+// minus or in parentheses. Leading and trailing spaces are ignored.
+//
+// Example usage:
+//
+//	f, err := g.ParseNumber("1,234.56", "en")   // 1234.56, nil
+//	f, err = g.ParseNumber("1 234,56", "uk")    // 1234.56, nil
+//	f, err = g.ParseNumber("1.234,56", "de")    // 1234.56, nil
+//	f, err = g.ParseNumber("(1.234,56)", "de")  // -1234.56, nil
+//	f, err = g.ParseNumber("1.234,56", "en")    // 0, error
+//	f, err = g.ParseNumber("1,5", "en")         // 0, error
+func ParseNumber(s string, locale string) (float64, error) {
+	l, ok := LookupLocale(locale)
+	if !ok {
+		return 0, fmt.Errorf("unknown locale %q", locale)
+	}
+
+	v := strings.TrimSpace(s)
+	if v == "" {
+		return 0, errors.New("empty string")
+	}
+
+	// Sign of the number.
+	negative := false
+	if r, size := utf8.DecodeRuneInString(v); r == '-' || r == '−' {
+		negative, v = true, v[size:]
+	} else if r == '+' {
+		v = v[size:]
+	} else if r == '(' && strings.HasSuffix(v, ")") {
+		negative, v = true, v[size:len(v)-1]
+	} else if strings.HasSuffix(v, "-") {
+		negative, v = true, v[:len(v)-1]
+	}
+
+	var (
+		sb       strings.Builder
+		prev     rune
+		decimal  bool // decimal separator found
+		exponent bool // exponent found
+		digits   bool // digits found
+		groups   int  // group separators found
+		groupLen int  // digits of the integer part after the last separator
+	)
+
+	// The first group has 1-3 digits, each next one exactly 3, so that
+	// a misread separator, e.g. "1,5" for the "en" locale, isn't ignored.
+	groupEnd := func() bool { return groups == 0 || groupLen == 3 }
+	groupingError := func() error {
+		return fmt.Errorf("invalid digit grouping in number %q for locale %q",
+			s, l.Name)
+	}
+
+	spaceGroup := unicode.IsSpace(l.Group)
+	for _, r := range v {
+		switch {
+		case r >= '0' && r <= '9':
+			digits = true
+			if !decimal && !exponent {
+				groupLen++
+			}
+			sb.WriteRune(r)
+		case r == l.Decimal && !decimal && !exponent:
+			if !groupEnd() {
+				return 0, groupingError()
+			}
+			decimal = true
+			sb.WriteByte('.')
+		case (r == l.Group || spaceGroup && unicode.IsSpace(r)) &&
+			digits && !decimal && !exponent:
+			// Group separators are allowed in the integer part only.
+			if groupLen == 0 || groupLen > 3 || !groupEnd() {
+				return 0, groupingError()
+			}
+			groups, groupLen = groups+1, 0
+		case (r == 'e' || r == 'E') && digits && !exponent:
+			if !decimal && !groupEnd() {
+				return 0, groupingError()
+			}
+			exponent = true
+			sb.WriteByte('e')
+		case (r == '-' || r == '+') && (prev == 'e' || prev == 'E'):
+			sb.WriteRune(r)
+		default:
+			return 0, fmt.Errorf(
+				"invalid character %q in number %q for locale %q",
+				r, s, l.Name,
+			)
+		}
+		prev = r
+	}
+
+	if !decimal && !exponent && !groupEnd() {
+		return 0, groupingError()
+	}
+
+	f, err := strconv.ParseFloat(sb.String(), 64)
+	if err != nil {
+		return 0, err
+	}
+
+	return If(negative, -f, f), nil
+}
+
+// The groupDigits function inserts the separator between every group
+// of size digits (three if the size isn't specified), counting from
+// the right.
+func groupDigits(digits string, sep rune, size ...int) string {
+	n := 3
+	if len(size) > 0 && size[0] > 0 {
+		n = size[0]
+	}
+
+	if len(digits) <= n {
+		return digits
+	}
+
+	var sb strings.Builder
+	first := len(digits) % n
+	if first == 0 {
+		first = n
+	}
+
+	sb.WriteString(digits[:first])
+	for i := first; i < len(digits); i += n {
+		sb.WriteRune(sep)
+		sb.WriteString(digits[i : i+n])
+	}
+
+	return sb.String()
+}
+
+// FormatNumber converts a number to a string according to the format
+// options: the locale's group and decimal separators, the precision,
+// the sign placement and the use of scientific notation.
+//
+// If no options are provided, the number is written in the "en" locale
+// with grouping and in the shortest representation. Integers are
+// formatted exactly, without conversion to float64.
+//
+// Example usage:
+//
+//	g.FormatNumber(1234567.891)  // Output: "1,234,567.891"
+//	g.FormatNumber(1234.5, g.NumberFormat{Locale: "de", Fixed: true,
+//	    Precision: 2})            // Output: "1.234,50"
+//	g.FormatNumber(-1234, g.NumberFormat{
+//	    Sign: g.SignParentheses}) // Output: "(1,234)"
+//	g.FormatNumber(1500000.0, g.NumberFormat{
+//	    Exponent: g.ExponentAlways}) // Output: "1.5e6"
+func FormatNumber[T Numerable](v T, opts ...NumberFormat) string {
+	var opt NumberFormat
+	if len(opts) > 0 {
+		opt = opts[0]
+	}
+
+	l, ok := LookupLocale(opt.Locale)
+	if !ok {
+		l, _ = LookupLocale("en")
+	}
+
+	kind := kindOf[T]()
+	negative := v < 0
+	prec := If(opt.Fixed, opt.Precision, -1)
+	if prec < -1 {
+		prec = 0
+	}
+
+	// The absolute value in the Go syntax.
+	var s string
+	switch {
+	case kind.float:
+		f := math.Abs(float64(v))
+		if math.IsNaN(f) {
+			return "NaN"
+		} else if math.IsInf(f, 0) {
+			return If(negative, "-Inf", "+Inf")
+		}
+
+		format := byte('f')
+		if opt.Exponent == ExponentAlways {
+			format = 'e'
+		} else if opt.Exponent == ExponentAuto && f != 0 {
+			if exp := math.Floor(math.Log10(f)); exp < -4 || exp >= 21 {
+				format = 'e'
+			}
+		}
+		s = strconv.FormatFloat(f, format, prec, int(kind.size))
+	case opt.Exponent == ExponentAlways:
+		s = strconv.FormatFloat(math.Abs(float64(v)), 'e', prec, 64)
+	case negative:
+		s = strconv.FormatUint(uint64(-int64(v)), 10)
+	default:
+		s = strconv.FormatUint(uint64(v), 10)
+	}
+
+	// Split into integer, fractional and exponent parts.
+	intPart, fracPart, expPart := s, "", ""
+	if i := strings.IndexByte(intPart, 'e'); i >= 0 {
+		exp, _ := strconv.Atoi(intPart[i+1:])
+		intPart, expPart = intPart[:i], "e"+strconv.Itoa(exp)
+	}
+
+	if i := strings.IndexByte(intPart, '.'); i >= 0 {
+		intPart, fracPart = intPart[:i], intPart[i+1:]
+	}
+
+	if !kind.float && prec > 0 && expPart == "" {
+		fracPart = strings.Repeat("0", prec)
+	}
+
+	if opt.TrimZeros {
+		fracPart = strings.TrimRight(fracPart, "0")
+	}
+
+	if !opt.NoGrouping {
+		intPart = groupDigits(intPart, l.Group)
+	}
+
+	result := intPart
+	if fracPart != "" {
+		result += string(l.Decimal) + fracPart
+	}
+	result += expPart
+
+	// The sign.
+	switch {
+	case negative && opt.Sign == SignTrailing:
+		return result + "-"
+	case negative && opt.Sign == SignParentheses:
+		return "(" + result + ")"
+	case negative:
+		return "-" + result
+	case opt.Sign == SignAlways && v != 0:
+		return "+" + result
+	}
+
+	return result
+}
@@ -0,0 +1,161 @@
+package g
+
+import (
+	"math"
+	"testing"
+)
+
+// TestParseNumber tests the ParseNumber function.
+func TestParseNumber(t *testing.T) {
+	tests := []struct {
+		input   string
+		locale  string
+		want    float64
+		wantErr bool
+	}{
+		{"1,234.56", "en", 1234.56, false},
+		{"1234.56", "en", 1234.56, false},
+		{"-1,234", "en", -1234, false},
+		{"+1,234", "en", 1234, false},
+		{"(1,234.5)", "en", -1234.5, false},
+		{"1,234-", "en", -1234, false},
+		{"1.5e3", "en", 1500, false},
+		{"1.5E-3", "en", 0.0015, false},
+		{"1 234,56", "uk", 1234.56, false},
+		{"1\u00a0234,56", "uk", 1234.56, false},
+		{"−1 234,56", "ru", -1234.56, false},
+		{"1.234,56", "de", 1234.56, false},
+		{"1\u202f234,56", "fr", 1234.56, false},
+		{"  42  ", "de-AT", 42, false},
+		{"1.234,56", "en", 0, true},
+		{"1,234.56", "de", 0, true},
+		{"12.34,5", "en", 0, true},
+		{"1,2a", "en", 0, true},
+		{"1,5", "en", 0, true},
+		{"12,34", "en", 0, true},
+		{"1234,567", "en", 0, true},
+		{"1,234,5678", "en", 0, true},
+		{"1,,234", "en", 0, true},
+		{"1,234,.5", "en", 0, true},
+		{"1,23e5", "en", 0, true},
+		{"1 23,5", "uk", 0, true},
+		{"1,234,567.5", "en", 1234567.5, false},
+		{"1,234e2", "en", 123400, false},
+		{"", "en", 0, true},
+		{"1", "xx", 0, true},
+	}
+
+	for _, tt := range tests {
+		got, err := ParseNumber(tt.input, tt.locale)
+		if (err != nil) != tt.wantErr {
+			t.Errorf("ParseNumber(%q, %q) error = %v, wantErr %v",
+				tt.input, tt.locale, err, tt.wantErr)
+			continue
+		}
+
+		if math.Abs(got-tt.want) > 1e-9 {
+			t.Errorf("ParseNumber(%q, %q) = %v, want %v",
+				tt.input, tt.locale, got, tt.want)
+		}
+	}
+}
+
+// TestFormatNumber tests the FormatNumber function.
+func TestFormatNumber(t *testing.T) {
+	tests := []struct {
+		name string
+		got  string
+		want string
+	}{
+		{"Default", FormatNumber(1234567.891), "1,234,567.891"},
+		{"Integer", FormatNumber(-1234567), "-1,234,567"},
+		{"Small", FormatNumber(12), "12"},
+		{"MinInt64", FormatNumber(int64(math.MinInt64)),
+			"-9,223,372,036,854,775,808"},
+		{"MaxUint64", FormatNumber(uint64(math.MaxUint64)),
+			"18,446,744,073,709,551,615"},
+		{"German", FormatNumber(1234.5, NumberFormat{
+			Locale: "de", Fixed: true, Precision: 2}), "1.234,50"},
+		{"Ukrainian", FormatNumber(1234.5, NumberFormat{Locale: "uk"}),
+			"1\u00a0234,5"},
+		{"Fixed integer", FormatNumber(5, NumberFormat{
+			Fixed: true, Precision: 2}), "5.00"},
+		{"Trim zeros", FormatNumber(2.5, NumberFormat{
+			Fixed: true, Precision: 4, TrimZeros: true}), "2.5"},
+		{"Trim all zeros", FormatNumber(2.0, NumberFormat{
+			Fixed: true, Precision: 3, TrimZeros: true}), "2"},
+		{"No grouping", FormatNumber(1234567, NumberFormat{
+			NoGrouping: true}), "1234567"},
+		{"Sign always", FormatNumber(15, NumberFormat{
+			Sign: SignAlways}), "+15"},
+		{"Sign always zero", FormatNumber(0, NumberFormat{
+			Sign: SignAlways}), "0"},
+		{"Sign trailing", FormatNumber(-1234, NumberFormat{
+			Sign: SignTrailing}), "1,234-"},
+		{"Sign parentheses", FormatNumber(-1234, NumberFormat{
+			Sign: SignParentheses}), "(1,234)"},
+		{"Exponent always", FormatNumber(1500000.0, NumberFormat{
+			Exponent: ExponentAlways}), "1.5e6"},
+		{"Exponent always int", FormatNumber(-1500, NumberFormat{
+			Exponent: ExponentAlways, Locale: "de"}), "-1,5e3"},
+		{"Exponent auto small", FormatNumber(0.00001234, NumberFormat{
+			Exponent: ExponentAuto}), "1.234e-5"},
+		{"Exponent auto", FormatNumber(1234.5, NumberFormat{
+			Exponent: ExponentAuto}), "1,234.5"},
+		{"Exponent never", FormatNumber(1e22), "10,000,000,000,000,000,000,000"},
+		{"Float32", FormatNumber(float32(0.1)), "0.1"},
+		{"NaN", FormatNumber(math.NaN()), "NaN"},
+		{"Unknown locale", FormatNumber(1234.5, NumberFormat{
+			Locale: "xx"}), "1,234.5"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if tt.got != tt.want {
+				t.Errorf("FormatNumber() = %q, want %q", tt.got, tt.want)
+			}
+		})
+	}
+}
+
+// TestFormatParseNumber tests that formatted numbers can be parsed back.
+func TestFormatParseNumber(t *testing.T) {
+	values := []float64{0, 1234.5, -98765.4321, 1e-3}
+	for _, locale := range []string{"en", "uk", "de", "fr", "ru"} {
+		for _, v := range values {
+			s := FormatNumber(v, NumberFormat{Locale: locale})
+			got, err := ParseNumber(s, locale)
+			if err != nil || got != v {
+				t.Errorf("ParseNumber(%q, %q) = %v, %v, want %v",
+					s, locale, got, err, v)
+			}
+		}
+	}
+}
+
+// TestRegisterLocale tests the RegisterLocale and LookupLocale functions.
+func TestRegisterLocale(t *testing.T) {
+	if err := RegisterLocale(Locale{Name: "CH", Decimal: '.', Group: '\''}); err != nil {
+		t.Fatalf("RegisterLocale() error = %v", err)
+	}
+
+	if l, ok := LookupLocale("ch-DE"); !ok || l.Group != '\'' {
+		t.Errorf("LookupLocale() = %v, %v", l, ok)
+	}
+
+	if got := FormatNumber(1234.5, NumberFormat{Locale: "ch"}); got != "1'234.5" {
+		t.Errorf("FormatNumber() = %q, want %q", got, "1'234.5")
+	}
+
+	if got, err := ParseNumber("1'234.5", "ch"); err != nil || got != 1234.5 {
+		t.Errorf("ParseNumber() = %v, %v", got, err)
+	}
+
+	if err := RegisterLocale(Locale{Name: "bad", Decimal: ',', Group: ','}); err == nil {
+		t.Errorf("RegisterLocale() expected error for equal separators")
+	}
+
+	if err := RegisterLocale(Locale{Decimal: '.', Group: ','}); err == nil {
+		t.Errorf("RegisterLocale() expected error for empty name")
+	}
+}
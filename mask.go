@@ -0,0 +1,297 @@
+package g
+
+import (
+	"regexp"
+	"sort"
+	"strings"
+	"unicode"
+)
+
+// Detector finds sensitive data in free text for the Redact function.
+//
+// The Pattern finds the candidates. If the pattern has a capturing
+// group, only the text of the first group is masked, e.g. the token
+// in `(?i)bearer\s+(\S+)`. The Validate function, if set, filters out
+// false positives, such as card numbers that fail the Luhn check.
+// The Mask function replaces the found text, by default every
+// character except whitespace is replaced by "*".
+type Detector struct {
+	Pattern  *regexp.Regexp
+	Validate func(s string) bool
+	Mask     func(s string) string
+}
+
+var (
+	// DetectorEmail finds email addresses and masks them
+	// by the MaskEmail function.
+	DetectorEmail = &Detector{
+		Pattern: regexp.MustCompile(
+			`[A-Za-z0-9._%+-]+@[A-Za-z0-9-]+(?:\.[A-Za-z0-9-]+)*\.[A-Za-z]{2,}`,
+		),
+		Mask: func(s string) string { return MaskEmail(s) },
+	}
+
+	// DetectorCard finds payment card numbers of 13-19 digits, optionally
+	// grouped by spaces or dashes, that pass the Luhn check, and masks
+	// them by the MaskCard function.
+	DetectorCard = &Detector{
+		Pattern: regexp.MustCompile(`\b\d(?:[ -]?\d){12,18}\b`),
+		Validate: func(s string) bool {
+			return IsLuhn(Preserve(s, Numbers))
+		},
+		Mask: func(s string) string { return MaskCard(s) },
+	}
+
+	// DetectorIBAN finds International Bank Account Numbers, compact or
+	// grouped by four characters, that pass the mod-97 check, and masks
+	// them keeping the country code and the last four characters.
+	DetectorIBAN = &Detector{
+		Pattern: regexp.MustCompile(
+			`\b[A-Z]{2}\d{2}(?:[A-Z0-9]{11,30}|` +
+				`(?: [A-Z0-9]{4}){2,7}(?: [A-Z0-9]{1,4})?)\b`,
+		),
+		Validate: IsIBAN,
+		Mask:     maskIBAN,
+	}
+
+	// DetectorToken finds secrets: JSON Web Tokens, bearer tokens,
+	// API keys with well-known prefixes (Stripe, GitHub, Slack, AWS)
+	// and long hexadecimal strings, and masks them keeping the first
+	// four characters.
+	DetectorToken = &Detector{
+		Pattern: regexp.MustCompile(`(?i:bearer)\s+([A-Za-z0-9._~+/-]{8,}=*)|` +
+			`\beyJ[A-Za-z0-9_-]+\.[A-Za-z0-9_-]+\.[A-Za-z0-9_-]+|` +
+			`\b(?:sk|pk|rk)_(?:live|test)_[A-Za-z0-9]{16,}|` +
+			`\bgh[pousr]_[A-Za-z0-9]{36,}|` +
+			`\bxox[abprs]-[A-Za-z0-9-]{10,}|` +
+			`\b(?:AKIA|ASIA)[0-9A-Z]{16}\b|` +
+			`\b[0-9A-Fa-f]{32,}\b`,
+		),
+		Mask: func(s string) string { return Mask(s, 4, 0) },
+	}
+
+	// The defaultDetectors are used if no detectors are specified.
+	defaultDetectors = []*Detector{
+		DetectorEmail,
+		DetectorIBAN,
+		DetectorCard,
+		DetectorToken,
+	}
+)
+
+// The maskRuneOf function returns the mask rune, "*" by default.
+func maskRuneOf(maskRune []rune) rune {
+	if len(maskRune) > 0 {
+		return maskRune[0]
+	}
+
+	return '*'
+}
+
+// The maskMatching function masks the characters of the string that
+// match the predicate, except keepStart first and keepEnd last of them.
+// If there are too few of them, all of them are masked.
+func maskMatching(
+	s string,
+	keepStart, keepEnd int,
+	mask rune,
+	match func(r rune) bool,
+) string {
+	rs := []rune(s)
+	total := 0
+	for _, r := range rs {
+		if match(r) {
+			total++
+		}
+	}
+
+	if keepStart < 0 || keepEnd < 0 || keepStart+keepEnd >= total {
+		keepStart, keepEnd = 0, 0
+	}
+
+	n := 0
+	for i, r := range rs {
+		if !match(r) {
+			continue
+		}
+
+		if n >= keepStart && n < total-keepEnd {
+			rs[i] = mask
+		}
+		n++
+	}
+
+	return string(rs)
+}
+
+// Mask replaces the characters of the string by the mask rune ("*" by
+// default), except keepStart first and keepEnd last characters.
+//
+// If the string is too short to keep the requested characters, it's
+// masked completely, so that short secrets aren't revealed.
+//
+// Example usage:
+//
+//	g.Mask("secret-token", 2, 2)       // Output: "se********en"
+//	g.Mask("Привіт", 1, 0, '#')        // Output: "П#####"
+//	g.Mask("abc", 2, 2)                // Output: "***"
+func Mask(s string, keepStart, keepEnd int, maskRune ...rune) string {
+	return maskMatching(s, keepStart, keepEnd, maskRuneOf(maskRune),
+		func(rune) bool { return true })
+}
+
+// MaskCard masks the digits of the payment card number except
+// the last four. Separators (spaces, dashes) are kept as is.
+//
+// Example usage:
+//
+//	g.MaskCard("4111 1111 1111 1111")  // Output: "**** **** **** 1111"
+//	g.MaskCard("4111-1111-1111-1111", 'x')
+//	// Output: "xxxx-xxxx-xxxx-1111"
+func MaskCard(s string, maskRune ...rune) string {
+	return maskMatching(s, 0, 4, maskRuneOf(maskRune), unicode.IsDigit)
+}
+
+// MaskPhone masks the digits of the phone number except the first two
+// and the last two, so that the country or operator code and the end
+// of the number can be recognized. The plus sign, spaces, dashes and
+// parentheses are kept as is.
+//
+// Example usage:
+//
+//	g.MaskPhone("+380 67 123 45 67")   // Output: "+38* ** *** ** 67"
+//	g.MaskPhone("(555) 123-4567")      // Output: "(55*) ***-**67"
+func MaskPhone(s string, maskRune ...rune) string {
+	return maskMatching(s, 2, 2, maskRuneOf(maskRune), unicode.IsDigit)
+}
+
+// MaskEmail masks the local part of the email address except its
+// first character and the domain name except its first character and
+// the top-level domain. A string without "@" is masked except its
+// first character.
+//
+// Example usage:
+//
+//	g.MaskEmail("john.doe@example.com")  // Output: "j*******@e******.com"
+//	g.MaskEmail("a@b.org")               // Output: "*@*.org"
+func MaskEmail(s string, maskRune ...rune) string {
+	mask := maskRuneOf(maskRune)
+	at := strings.LastIndexByte(s, '@')
+	if at < 0 {
+		return Mask(s, 1, 0, mask)
+	}
+
+	local, domain, tld := s[:at], s[at+1:], ""
+	if dot := strings.LastIndexByte(domain, '.'); dot >= 0 {
+		domain, tld = domain[:dot], domain[dot:]
+	}
+
+	return Mask(local, 1, 0, mask) + "@" + Mask(domain, 1, 0, mask) + tld
+}
+
+// The maskIBAN function masks the IBAN except the country code
+// and the last four characters, spaces are kept as is.
+func maskIBAN(s string) string {
+	masked := maskMatching(s[2:], 0, 4, '*', func(r rune) bool {
+		return r != ' '
+	})
+
+	return s[:2] + masked
+}
+
+// The maskDefault function masks all characters except whitespace.
+func maskDefault(s string) string {
+	return maskMatching(s, 0, 0, '*', func(r rune) bool {
+		return !unicode.IsSpace(r)
+	})
+}
+
+// Redact finds sensitive data in the text by the detectors and masks it,
+// e.g. to sanitize logs. If no detectors are specified, the DetectorEmail,
+// DetectorIBAN, DetectorCard and DetectorToken detectors are used.
+//
+// The detectors are applied in order, text found by a detector is not
+// examined by the next ones.
+//
+// Example usage:
+//
+//	g.Redact("user john@example.com paid with 4111 1111 1111 1111")
+//	// Output: "user j***@e******.com paid with **** **** **** 1111"
+//
+//	g.Redact("Authorization: Bearer abcdef123456")
+//	// Output: "Authorization: Bearer abcd********"
+//
+//	password := &g.Detector{Pattern: regexp.MustCompile(`password=(\S+)`)}
+//	g.Redact("login=bob password=qwerty", password)
+//	// Output: "login=bob password=******"
+func Redact(text string, detectors ...*Detector) string {
+	if len(detectors) == 0 {
+		detectors = defaultDetectors
+	}
+
+	// Byte ranges of the text found by the detectors,
+	// with their masking functions.
+	type span struct {
+		start, end int
+		mask       func(s string) string
+	}
+
+	var spans []span
+	overlaps := func(start, end int) bool {
+		for _, sp := range spans {
+			if start < sp.end && end > sp.start {
+				return true
+			}
+		}
+
+		return false
+	}
+
+	for _, d := range detectors {
+		if d == nil || d.Pattern == nil {
+			continue
+		}
+
+		mask := d.Mask
+		if mask == nil {
+			mask = maskDefault
+		}
+
+		for _, m := range d.Pattern.FindAllStringSubmatchIndex(text, -1) {
+			start, end := m[0], m[1]
+			if len(m) >= 4 && m[2] >= 0 {
+				start, end = m[2], m[3]
+			}
+
+			if start == end || overlaps(m[0], m[1]) {
+				continue
+			}
+
+			if d.Validate != nil && !d.Validate(text[start:end]) {
+				continue
+			}
+
+			spans = append(spans, span{start, end, mask})
+		}
+	}
+
+	if len(spans) == 0 {
+		return text
+	}
+
+	sort.Slice(spans, func(i, j int) bool {
+		return spans[i].start < spans[j].start
+	})
+
+	var sb strings.Builder
+	sb.Grow(len(text))
+	last := 0
+	for _, sp := range spans {
+		sb.WriteString(text[last:sp.start])
+		sb.WriteString(sp.mask(text[sp.start:sp.end]))
+		last = sp.end
+	}
+	sb.WriteString(text[last:])
+
+	return sb.String()
+}
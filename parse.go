@@ -0,0 +1,171 @@
+package g
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+)
+
+// The splitNumber function prepares a string with a number for parsing
+// by the strconv package. It splits the string into the sign and the
+// rest, and reports whether the rest has a base prefix (0x, 0b, 0o).
+//
+// Leading zeros of decimal numbers, with underscores after them, are
+// removed so that they are not parsed as octal numbers in the Go syntax
+// (e.g. "0755" and "0_755" are 755).
+func splitNumber(s string) (sign, rest string, prefixed bool) {
+	rest = s
+	if len(rest) > 0 && (rest[0] == '+' || rest[0] == '-') {
+		sign, rest = rest[:1], rest[1:]
+	}
+
+	if len(rest) > 1 && rest[0] == '0' &&
+		strings.ContainsRune("xXbBoO", rune(rest[1])) {
+		return sign, rest, true
+	}
+
+	digits := rest
+	for len(digits) > 1 && digits[0] == '0' {
+		if isDigit(digits[1]) {
+			digits = digits[1:]
+		} else if digits[1] == '_' && len(digits) > 2 && isDigit(digits[2]) {
+			digits = digits[2:]
+		} else {
+			break
+		}
+	}
+
+	// A base prefix after leading zeros, e.g. "00x10", is invalid,
+	// the string is left as is for strconv to reject it.
+	if len(digits) > 1 && digits[0] == '0' &&
+		strings.ContainsRune("xXbBoO", rune(digits[1])) {
+		return sign, rest, false
+	}
+
+	return sign, digits, false
+}
+
+// The parseSigned function parses a signed integer of the given
+// bit size, accepting base prefixes and underscores.
+func parseSigned(s string, bitSize int) (int64, error) {
+	sign, rest, _ := splitNumber(s)
+	return strconv.ParseInt(sign+rest, 0, bitSize)
+}
+
+// The parseUnsigned function parses an unsigned integer of the given
+// bit size, accepting base prefixes, underscores and the plus sign.
+func parseUnsigned(s string, bitSize int) (uint64, error) {
+	sign, rest, _ := splitNumber(s)
+	u, err := strconv.ParseUint(rest, 0, bitSize)
+	if err == nil && sign == "-" && u != 0 {
+		return 0, &strconv.NumError{
+			Func: "ParseUint",
+			Num:  s,
+			Err:  strconv.ErrRange,
+		}
+	}
+
+	return u, err
+}
+
+// The parseFloat function parses a floating-point number of the given
+// bit size. Integers with base prefixes (without the 'p' exponent of
+// the hexadecimal floating-point syntax) are accepted as well.
+func parseFloat(s string, bitSize int) (float64, error) {
+	sign, rest, prefixed := splitNumber(s)
+	if prefixed && !strings.ContainsAny(rest, "pP") {
+		u, err := strconv.ParseUint(rest, 0, 64)
+		if err != nil {
+			return 0, err
+		}
+
+		f := float64(u)
+		return If(sign == "-", -f, f), nil
+	}
+
+	return strconv.ParseFloat(sign+rest, bitSize)
+}
+
+// StringTo converts a string to a value of type T.
+//
+// The type T can be any integer type (int, int8, ..., uint64), any
+// floating-point type, a boolean or a string type, including custom
+// types based on them. The value is parsed directly into the target
+// type with range checking, so "300" cannot be parsed into int8.
+//
+// Numbers may contain underscores between digits and base prefixes:
+// 0x (hexadecimal), 0b (binary) and 0o (octal). Leading zeros do not
+// make a number octal, "0755" is parsed as 755. Booleans are parsed
+// as the StringToBool function does, strings are returned as is.
+//
+// If the conversion fails and a default value is provided, it
+// returns the default value. Otherwise, it returns an error.
+//
+// Example usage:
+//
+//	i, err := g.StringTo[int8]("100")         // 100, nil
+//	i, err = g.StringTo[int8]("300")          // 0, error (out of range)
+//	u, err := g.StringTo[uint16]("0xFF_FF")   // 65535, nil
+//	b, err := g.StringTo[uint8]("0b1010")     // 10, nil
+//	f, err := g.StringTo[float32]("1_000.5")  // 1000.5, nil
+//	v, err := g.StringTo("abc", 7)            // 7, error
+func StringTo[T Numerable | ~bool | ~string](s string, def ...T) (T, error) {
+	var d, result T
+
+	if len(def) > 0 {
+		d = def[0]
+	}
+
+	if s == "" {
+		return d, errors.New("empty string and no default value")
+	}
+
+	if err := setFromString(reflect.ValueOf(&result).Elem(), s); err != nil {
+		return d, err
+	}
+
+	return result, nil
+}
+
+// The setFromString function parses the string according to the kind
+// of the value and sets the result to the value. It supports integer,
+// floating-point, boolean and string kinds, and returns an error for
+// any other kind.
+func setFromString(rv reflect.Value, s string) error {
+	switch rv.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32,
+		reflect.Int64:
+		i, err := parseSigned(s, rv.Type().Bits())
+		if err != nil {
+			return err
+		}
+		rv.SetInt(i)
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32,
+		reflect.Uint64, reflect.Uintptr:
+		u, err := parseUnsigned(s, rv.Type().Bits())
+		if err != nil {
+			return err
+		}
+		rv.SetUint(u)
+	case reflect.Float32, reflect.Float64:
+		f, err := parseFloat(s, rv.Type().Bits())
+		if err != nil {
+			return err
+		}
+		rv.SetFloat(f)
+	case reflect.Bool:
+		b, err := StringToBool(s)
+		if err != nil {
+			return err
+		}
+		rv.SetBool(b)
+	case reflect.String:
+		rv.SetString(s)
+	default:
+		return fmt.Errorf("cannot convert string to %s", rv.Type())
+	}
+
+	return nil
+}
@@ -0,0 +1,145 @@
+package g
+
+import (
+	"math"
+	"testing"
+)
+
+// TestStringToSigned tests the StringTo function with signed integers.
+func TestStringToSigned(t *testing.T) {
+	tests := []struct {
+		input   string
+		want    int8
+		def     int8
+		wantErr bool
+	}{
+		{"100", 100, 0, false},
+		{"-128", -128, 0, false},
+		{"+12", 12, 0, false},
+		{"0x7F", 127, 0, false},
+		{"-0b101", -5, 0, false},
+		{"0o17", 15, 0, false},
+		{"017", 17, 0, false},
+		{"1_0", 10, 0, false},
+		{"0_17", 17, 0, false},
+		{"-00_17", -17, 0, false},
+		{"00x10", 0, 0, true},
+		{"-00b1", 0, 0, true},
+		{"0__17", 0, 0, true},
+		{"300", 7, 7, true},
+		{"-129", 0, 0, true},
+		{"1__0", 0, 0, true},
+		{"abc", 3, 3, true},
+		{"", 5, 5, true},
+	}
+
+	for _, tt := range tests {
+		got, err := StringTo(tt.input, tt.def)
+		if (err != nil) != tt.wantErr {
+			t.Errorf("StringTo(%q) error = %v, wantErr %v",
+				tt.input, err, tt.wantErr)
+			continue
+		}
+
+		if got != tt.want {
+			t.Errorf("StringTo(%q) = %d, want %d", tt.input, got, tt.want)
+		}
+	}
+}
+
+// TestStringToUnsigned tests the StringTo function with unsigned integers.
+func TestStringToUnsigned(t *testing.T) {
+	tests := []struct {
+		input   string
+		want    uint16
+		wantErr bool
+	}{
+		{"65535", 65535, false},
+		{"0xFF_FF", 65535, false},
+		{"+0b1010", 10, false},
+		{"-0", 0, false},
+		{"0_755", 755, false},
+		{"00x10", 0, true},
+		{"-00b1", 0, true},
+		{"65536", 0, true},
+		{"-1", 0, true},
+	}
+
+	for _, tt := range tests {
+		got, err := StringTo[uint16](tt.input)
+		if (err != nil) != tt.wantErr {
+			t.Errorf("StringTo(%q) error = %v, wantErr %v",
+				tt.input, err, tt.wantErr)
+			continue
+		}
+
+		if got != tt.want {
+			t.Errorf("StringTo(%q) = %d, want %d", tt.input, got, tt.want)
+		}
+	}
+
+	if got, err := StringTo[uint64]("18446744073709551615"); err != nil ||
+		got != math.MaxUint64 {
+		t.Errorf("StringTo[uint64]() = %v, %v", got, err)
+	}
+}
+
+// TestStringToFloating tests the StringTo function with floating-point types.
+func TestStringToFloating(t *testing.T) {
+	tests := []struct {
+		input   string
+		want    float32
+		wantErr bool
+	}{
+		{"3.5", 3.5, false},
+		{"1_000.5", 1000.5, false},
+		{"-0x10", -16, false},
+		{"0x1p4", 16, false},
+		{"0b11", 3, false},
+		{"0_755", 755, false},
+		{"00x10", 0, true},
+		{"-00b1", 0, true},
+		{"1e39", 0, true},
+		{"1.2.3", 0, true},
+	}
+
+	for _, tt := range tests {
+		got, err := StringTo[float32](tt.input)
+		if (err != nil) != tt.wantErr {
+			t.Errorf("StringTo(%q) error = %v, wantErr %v",
+				tt.input, err, tt.wantErr)
+			continue
+		}
+
+		if !tt.wantErr && got != tt.want {
+			t.Errorf("StringTo(%q) = %v, want %v", tt.input, got, tt.want)
+		}
+	}
+}
+
+// TestStringToOther tests the StringTo function with booleans, strings
+// and custom types.
+func TestStringToOther(t *testing.T) {
+	type level int8
+	type name string
+
+	if got, err := StringTo[bool]("yes"); err != nil || !got {
+		t.Errorf("StringTo[bool](\"yes\") = %v, %v", got, err)
+	}
+
+	if got, err := StringTo("maybe", true); err == nil || !got {
+		t.Errorf("StringTo(\"maybe\", true) = %v, %v", got, err)
+	}
+
+	if got, err := StringTo[name]("goloop"); err != nil || got != "goloop" {
+		t.Errorf("StringTo[name]() = %v, %v", got, err)
+	}
+
+	if got, err := StringTo[level]("0x0A"); err != nil || got != 10 {
+		t.Errorf("StringTo[level]() = %v, %v", got, err)
+	}
+
+	if _, err := StringTo[level]("200"); err == nil {
+		t.Errorf("StringTo[level](\"200\") expected range error")
+	}
+}
@@ -0,0 +1,157 @@
+package g
+
+import "math"
+
+// Clamp limits the value v to the closed range [lo, hi].
+//
+// If v is less than lo, the function returns lo; if v is greater
+// than hi, it returns hi; otherwise it returns v unchanged.
+// If lo is greater than hi, the bounds are swapped.
+//
+// Example usage:
+//
+//	g.Clamp(15, 0, 10)      // Output: 10
+//	g.Clamp(-3, 0, 10)      // Output: 0
+//	g.Clamp(0.5, 0.0, 1.0)  // Output: 0.5
+//	g.Clamp("m", "a", "f")  // Output: "f"
+func Clamp[T Ordered](v, lo, hi T) T {
+	if lo > hi {
+		lo, hi = hi, lo
+	}
+
+	if v < lo {
+		return lo
+	} else if v > hi {
+		return hi
+	}
+
+	return v
+}
+
+// Lerp returns the linear interpolation between a and b
+// by the factor t.
+//
+// When t is 0 the function returns a, when t is 1 it returns b.
+// Values of t outside the [0, 1] range extrapolate beyond a and b,
+// use Clamp on t if that is not desired.
+//
+// Example usage:
+//
+//	g.Lerp(0.0, 10.0, 0.5)   // Output: 5
+//	g.Lerp(10.0, 20.0, 0.25) // Output: 12.5
+func Lerp[T Float](a, b, t T) T {
+	return a + (b-a)*t
+}
+
+// InverseLerp returns the factor t for which Lerp(a, b, t) is v.
+//
+// It is the inverse operation of the Lerp function. If a and b are
+// equal, the function returns 0 because any factor satisfies
+// the equation.
+//
+// Example usage:
+//
+//	g.InverseLerp(0.0, 10.0, 5.0)   // Output: 0.5
+//	g.InverseLerp(10.0, 20.0, 12.5) // Output: 0.25
+func InverseLerp[T Float](a, b, v T) T {
+	if a == b {
+		return 0
+	}
+
+	return (v - a) / (b - a)
+}
+
+// Normalize performs min-max normalization of the values and returns
+// a new slice where the smallest value is mapped to 0, the largest
+// value is mapped to 1 and all others are scaled proportionally.
+//
+// If all values are equal, all elements of the result are 0.
+// Large slices are processed concurrently.
+//
+// Example usage:
+//
+//	g.Normalize([]int{10, 15, 20})  // Output: [0 0.5 1]
+//	g.Normalize([]float64{2, 2, 2}) // Output: [0 0 0]
+func Normalize[T Numerable](v []T) []float64 {
+	return Rescale(v, 0, 1)
+}
+
+// Rescale maps the values linearly to the range [lo, hi] and returns
+// a new slice. The smallest value of v is mapped to lo, the largest
+// value of v is mapped to hi.
+//
+// If all values are equal, all elements of the result are lo.
+// Large slices are processed concurrently.
+//
+// Example usage:
+//
+//	g.Rescale([]int{0, 5, 10}, -1, 1)       // Output: [-1 0 1]
+//	g.Rescale([]float64{1, 2, 3}, 0, 100)   // Output: [0 50 100]
+func Rescale[T Numerable](v []T, lo, hi float64) []float64 {
+	result := make([]float64, len(v))
+	if len(v) == 0 {
+		return result
+	}
+
+	min, max := float64(Min(v...)), float64(Max(v...))
+	span := max - min
+	doInChunks(len(v), func(start, end int) {
+		for i := start; i < end; i++ {
+			if span == 0 {
+				result[i] = lo
+				continue
+			}
+
+			result[i] = lo + (float64(v[i])-min)/span*(hi-lo)
+		}
+	})
+
+	return result
+}
+
+// Standardize returns the z-scores of the values: the number of
+// standard deviations by which each value is above or below the mean.
+//
+// The population standard deviation is used. If it is zero (all
+// values are equal), all elements of the result are 0.
+// Large slices are processed concurrently.
+//
+// Example usage:
+//
+//	g.Standardize([]int{2, 4, 4, 4, 5, 5, 7, 9})
+//	// Output: [-1.5 -0.5 -0.5 -0.5 0 0 1 2]
+func Standardize[T Numerable](v []T) []float64 {
+	result := make([]float64, len(v))
+	if len(v) == 0 {
+		return result
+	}
+
+	// Average sums values in type T, which can overflow
+	// for small integer types, so work with float64 copies.
+	doInChunks(len(v), func(start, end int) {
+		for i := start; i < end; i++ {
+			result[i] = float64(v[i])
+		}
+	})
+
+	mean := Average(result...)
+	variance := 0.0
+	for _, val := range result {
+		d := val - mean
+		variance += d * d
+	}
+
+	std := math.Sqrt(variance / float64(len(v)))
+	doInChunks(len(v), func(start, end int) {
+		for i := start; i < end; i++ {
+			if std == 0 {
+				result[i] = 0
+				continue
+			}
+
+			result[i] = (result[i] - mean) / std
+		}
+	})
+
+	return result
+}
@@ -0,0 +1,174 @@
+package g
+
+import (
+	"math"
+	"reflect"
+	"testing"
+)
+
+// almostEqualSlices checks that two float64 slices are equal
+// with a small tolerance.
+func almostEqualSlices(a, b []float64) bool {
+	if len(a) != len(b) {
+		return false
+	}
+
+	for i := range a {
+		if math.Abs(a[i]-b[i]) > 1e-9 {
+			return false
+		}
+	}
+
+	return true
+}
+
+// TestClamp tests the Clamp function.
+func TestClamp(t *testing.T) {
+	tests := []struct {
+		name      string
+		v, lo, hi int
+		want      int
+	}{
+		{"Inside", 5, 0, 10, 5},
+		{"Below", -3, 0, 10, 0},
+		{"Above", 15, 0, 10, 10},
+		{"On bound", 10, 0, 10, 10},
+		{"Swapped bounds", 15, 10, 0, 10},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := Clamp(tt.v, tt.lo, tt.hi); got != tt.want {
+				t.Errorf("Clamp() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+
+	if got := Clamp("m", "a", "f"); got != "f" {
+		t.Errorf("Clamp() = %v, want %v", got, "f")
+	}
+
+	if got := Clamp(0.5, 0.0, 1.0); got != 0.5 {
+		t.Errorf("Clamp() = %v, want %v", got, 0.5)
+	}
+}
+
+// TestLerp tests the Lerp and InverseLerp functions.
+func TestLerp(t *testing.T) {
+	tests := []struct {
+		name    string
+		a, b, t float64
+		want    float64
+	}{
+		{"Start", 0, 10, 0, 0},
+		{"End", 0, 10, 1, 10},
+		{"Middle", 10, 20, 0.25, 12.5},
+		{"Extrapolate", 0, 10, 2, 20},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := Lerp(tt.a, tt.b, tt.t); got != tt.want {
+				t.Errorf("Lerp() = %v, want %v", got, tt.want)
+			}
+
+			if got := InverseLerp(tt.a, tt.b, tt.want); got != tt.t {
+				t.Errorf("InverseLerp() = %v, want %v", got, tt.t)
+			}
+		})
+	}
+
+	if got := InverseLerp(float32(3), 3, 7); got != 0 {
+		t.Errorf("InverseLerp() = %v, want 0", got)
+	}
+}
+
+// TestNormalize tests the Normalize function.
+func TestNormalize(t *testing.T) {
+	tests := []struct {
+		name string
+		v    []int
+		want []float64
+	}{
+		{"Empty", []int{}, []float64{}},
+		{"Simple", []int{10, 15, 20}, []float64{0, 0.5, 1}},
+		{"Unordered", []int{5, -5, 0}, []float64{1, 0, 0.5}},
+		{"Equal", []int{2, 2, 2}, []float64{0, 0, 0}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := Normalize(tt.v)
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Normalize() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+// TestRescale tests the Rescale function.
+func TestRescale(t *testing.T) {
+	got := Rescale([]int{0, 5, 10}, -1, 1)
+	if want := []float64{-1, 0, 1}; !almostEqualSlices(got, want) {
+		t.Errorf("Rescale() = %v, want %v", got, want)
+	}
+
+	got = Rescale([]float64{1, 2, 3}, 100, 0)
+	if want := []float64{100, 50, 0}; !almostEqualSlices(got, want) {
+		t.Errorf("Rescale() = %v, want %v", got, want)
+	}
+
+	got = Rescale([]uint8{7, 7}, 3, 5)
+	if want := []float64{3, 3}; !almostEqualSlices(got, want) {
+		t.Errorf("Rescale() = %v, want %v", got, want)
+	}
+}
+
+// TestRescaleLargeData tests the Rescale function with data
+// large enough to trigger parallel execution.
+func TestRescaleLargeData(t *testing.T) {
+	size := minLoadPerGoroutine*parallelTasks + 7
+	values := make([]int, size)
+	for i := range values {
+		values[i] = i
+	}
+
+	got := Rescale(values, 0, float64(size-1))
+	for i, val := range got {
+		if math.Abs(val-float64(i)) > 1e-6 {
+			t.Fatalf("Rescale()[%d] = %v, want %v", i, val, i)
+		}
+	}
+}
+
+// TestStandardize tests the Standardize function.
+func TestStandardize(t *testing.T) {
+	tests := []struct {
+		name string
+		v    []int
+		want []float64
+	}{
+		{"Empty", []int{}, []float64{}},
+		{
+			"Simple",
+			[]int{2, 4, 4, 4, 5, 5, 7, 9},
+			[]float64{-1.5, -0.5, -0.5, -0.5, 0, 0, 1, 2},
+		},
+		{"Equal", []int{3, 3, 3}, []float64{0, 0, 0}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := Standardize(tt.v)
+			if !almostEqualSlices(got, tt.want) {
+				t.Errorf("Standardize() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+
+	// Sum of small integer types overflows, the result must not.
+	got := Standardize([]uint8{200, 250})
+	if want := []float64{-1, 1}; !almostEqualSlices(got, want) {
+		t.Errorf("Standardize() = %v, want %v", got, want)
+	}
+}
@@ -0,0 +1,90 @@
+package g
+
+import (
+	"strings"
+	"unicode"
+)
+
+// SlugOptions is a set of options for the Slugify function.
+type SlugOptions struct {
+	// Separator joins the words of the slug, "-" is used if it's empty.
+	Separator string
+
+	// MaxLength is the maximum length of the slug in bytes, the slug is
+	// cut on a word boundary. A single word longer than the limit is cut
+	// to the limit. Zero means no limit.
+	MaxLength int
+
+	// KeepCase keeps the case of letters, by default
+	// the slug is lowercase.
+	KeepCase bool
+
+	// Tables are the transliteration tables, the default tables
+	// of the Transliterate function are used if it's empty.
+	Tables []*TranslitTable
+}
+
+// Slugify converts the string to a URL-friendly slug.
+//
+// The string is transliterated to Latin (see the Transliterate function),
+// apostrophes are removed and the words of ASCII letters and digits are
+// joined by the separator; all other characters are dropped, including
+// non-Latin characters that are missing in the transliteration tables.
+//
+// Example usage:
+//
+//	g.Slugify("Київ — столиця України!")
+//	// Output: "kyiv-stolytsia-ukrainy"
+//
+//	g.Slugify("Crème Brûlée: the Recipe", g.SlugOptions{
+//	    Separator: "_",
+//	    MaxLength: 12,
+//	})
+//	// Output: "creme_brulee"
+func Slugify(s string, opts ...SlugOptions) string {
+	var opt SlugOptions
+	if len(opts) > 0 {
+		opt = opts[0]
+	}
+
+	if opt.Separator == "" {
+		opt.Separator = "-"
+	}
+
+	s = Transliterate(s, opt.Tables...)
+	s = strings.Map(func(r rune) rune {
+		return If(isApostrophe(r), -1, r)
+	}, s)
+
+	words := strings.FieldsFunc(s, func(r rune) bool {
+		return r > unicode.MaxASCII ||
+			!unicode.IsLetter(r) && !unicode.IsDigit(r)
+	})
+
+	var sb strings.Builder
+	for _, word := range words {
+		if !opt.KeepCase {
+			word = strings.ToLower(word)
+		}
+
+		size := len(word)
+		if sb.Len() > 0 {
+			size += len(opt.Separator)
+		}
+
+		if opt.MaxLength > 0 && sb.Len()+size > opt.MaxLength {
+			// A single word longer than the limit is cut.
+			if sb.Len() == 0 {
+				sb.WriteString(word[:opt.MaxLength])
+			}
+			break
+		}
+
+		if sb.Len() > 0 {
+			sb.WriteString(opt.Separator)
+		}
+		sb.WriteString(word)
+	}
+
+	return sb.String()
+}
@@ -0,0 +1,45 @@
+package g
+
+import "testing"
+
+// TestSlugify tests the Slugify function.
+func TestSlugify(t *testing.T) {
+	tests := []struct {
+		name string
+		s    string
+		opts []SlugOptions
+		want string
+	}{
+		{"Ukrainian", "Київ — столиця України!", nil,
+			"kyiv-stolytsia-ukrainy"},
+		{"Apostrophes", "Don't stop: п'ять кроків", nil,
+			"dont-stop-piat-krokiv"},
+		{"Diacritics", "Crème Brûlée: the Recipe", nil,
+			"creme-brulee-the-recipe"},
+		{"German", "Größe über alles", nil, "groesse-ueber-alles"},
+		{"Separator", "Hello, World", []SlugOptions{{Separator: "_"}},
+			"hello_world"},
+		{"Max length", "Crème Brûlée: the Recipe",
+			[]SlugOptions{{MaxLength: 16}}, "creme-brulee-the"},
+		{"Max length boundary", "Crème Brûlée: the Recipe",
+			[]SlugOptions{{MaxLength: 15}}, "creme-brulee"},
+		{"Long word", "Supercalifragilistic", []SlugOptions{{MaxLength: 5}},
+			"super"},
+		{"Keep case", "Hello World", []SlugOptions{{KeepCase: true}},
+			"Hello-World"},
+		{"Russian", "Игорь съел ёжика", nil, "igor-sieel-ezhika"},
+		{"Tables", "Гора", []SlugOptions{{
+			Tables: []*TranslitTable{TranslitRussian},
+		}}, "gora"},
+		{"Untransliterated", "漢字 go 2024", nil, "go-2024"},
+		{"Empty", "  --  ", nil, ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := Slugify(tt.s, tt.opts...); got != tt.want {
+				t.Errorf("Slugify() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
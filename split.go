@@ -0,0 +1,291 @@
+package g
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+// SplitOptions is a set of options for the SplitQuoted function.
+type SplitOptions struct {
+	// Separators are the characters that separate fields, any of them
+	// is a separator (as in the Weed patterns); "," is used if it's empty.
+	Separators string
+
+	// Quotes are the characters that quote fields or their parts,
+	// a quoted part ends with the same character; `"` is used if it's
+	// empty. Separators inside quotes are a part of the field.
+	Quotes string
+
+	// Escape is the character that makes the next character a part
+	// of the field, e.g. '\\'; zero means no escape character.
+	Escape rune
+
+	// TrimSpace removes white space around fields,
+	// white space inside quotes is kept.
+	TrimSpace bool
+
+	// SkipEmpty drops empty fields, except explicitly quoted
+	// ones, e.g. `""`.
+	SkipEmpty bool
+
+	// KeepQuotes keeps the quote characters in the fields.
+	KeepQuotes bool
+}
+
+// SplitQuoted splits the string into fields by the separators, as the
+// strings.Split function does, but separators inside quotes or after
+// the escape character don't split the string. The quotes are removed
+// unless the KeepQuotes option is set.
+//
+// By default, the string is split by commas and the double quote
+// is used for quoting. The function returns an error if a quote
+// isn't closed or the string ends with the escape character.
+//
+// Example usage:
+//
+//	g.SplitQuoted(`a, "b, c", d`, g.SplitOptions{TrimSpace: true})
+//	// Output: ["a", "b, c", "d"], nil
+//
+//	g.SplitQuoted(`key=value; name='John; Doe';`, g.SplitOptions{
+//	    Separators: ";",
+//	    Quotes:     `"'`,
+//	    TrimSpace:  true,
+//	    SkipEmpty:  true,
+//	})
+//	// Output: ["key=value", "name=John; Doe"], nil
+//
+//	g.SplitQuoted(`a\,b,c`, g.SplitOptions{Escape: '\\'})
+//	// Output: ["a,b", "c"], nil
+func SplitQuoted(s string, opts ...SplitOptions) ([]string, error) {
+	var opt SplitOptions
+	if len(opts) > 0 {
+		opt = opts[0]
+	}
+
+	if opt.Separators == "" {
+		opt.Separators = ","
+	}
+
+	if opt.Quotes == "" {
+		opt.Quotes = `"`
+	}
+
+	separators, quotes := newRuneSet(opt.Separators), newRuneSet(opt.Quotes)
+
+	var (
+		result   []string
+		field    strings.Builder
+		quoted   bool // the field has a quoted part
+		keep     int  // length of the field that isn't trimmed
+		quote    rune // the current quote, zero outside quotes
+		quotePos int  // position of the current quote
+	)
+
+	flush := func() {
+		f := field.String()
+		if opt.TrimSpace {
+			f = f[:keep] + strings.TrimRightFunc(f[keep:], unicode.IsSpace)
+		}
+
+		if f != "" || quoted || !opt.SkipEmpty {
+			result = append(result, f)
+		}
+
+		field.Reset()
+		quoted, keep = false, 0
+	}
+
+	for i := 0; i < len(s); {
+		r, size := utf8.DecodeRuneInString(s[i:])
+		switch {
+		case opt.Escape != 0 && r == opt.Escape:
+			next, n := utf8.DecodeRuneInString(s[i+size:])
+			if n == 0 {
+				return nil, errors.New(
+					"escape character at the end of the string")
+			}
+
+			field.WriteRune(next)
+			keep = field.Len()
+			size += n
+		case quote != 0:
+			if r == quote {
+				quote = 0
+				if opt.KeepQuotes {
+					field.WriteRune(r)
+				}
+			} else {
+				field.WriteRune(r)
+			}
+			keep = field.Len()
+		case quotes.contains(r):
+			quote, quotePos, quoted = r, i, true
+			if opt.KeepQuotes {
+				field.WriteRune(r)
+			}
+			keep = field.Len()
+		case separators.contains(r):
+			flush()
+		case opt.TrimSpace && field.Len() == 0 && !quoted &&
+			unicode.IsSpace(r):
+			// Leading white space.
+		default:
+			field.WriteRune(r)
+		}
+
+		i += size
+	}
+
+	if quote != 0 {
+		return nil, fmt.Errorf("unclosed quote %q at position %d",
+			quote, quotePos)
+	}
+
+	flush()
+	if result == nil {
+		result = []string{}
+	}
+
+	return result, nil
+}
+
+// ShellSplit splits the command line into arguments according to the
+// POSIX shell rules: arguments are separated by spaces, tabs and newlines;
+// characters inside single quotes are taken literally; inside double
+// quotes, the backslash escapes only $, `, ", \ and the newline; outside
+// quotes, the backslash escapes any character, and a backslash followed
+// by a newline is removed. A "#" at the start of an argument starts
+// a comment up to the end of the line.
+//
+// Variables, globs and other expansions are not performed.
+// The function returns an error if a quote isn't closed or the string
+// ends with a backslash.
+//
+// Example usage:
+//
+//	g.ShellSplit(`cmd --flag "two words" 'it''s' a\ b`)
+//	// Output: ["cmd", "--flag", "two words", "its", "a b"], nil
+func ShellSplit(s string) ([]string, error) {
+	var (
+		args   = []string{}
+		arg    strings.Builder
+		inWord bool
+	)
+
+	for i := 0; i < len(s); {
+		// Special characters are ASCII, so bytes of multibyte
+		// characters are copied as is.
+		c := s[i]
+		switch {
+		case c == ' ' || c == '\t' || c == '\n':
+			if inWord {
+				args = append(args, arg.String())
+				arg.Reset()
+				inWord = false
+			}
+			i++
+		case c == '#' && !inWord:
+			if end := strings.IndexByte(s[i:], '\n'); end >= 0 {
+				i += end
+			} else {
+				i = len(s)
+			}
+		case c == '\\':
+			if i+1 >= len(s) {
+				return nil, errors.New("backslash at the end of the string")
+			}
+
+			// A backslash-newline pair is a line continuation.
+			if s[i+1] != '\n' {
+				arg.WriteByte(s[i+1])
+				inWord = true
+			}
+			i += 2
+		case c == '\'':
+			end := strings.IndexByte(s[i+1:], '\'')
+			if end < 0 {
+				return nil, fmt.Errorf("unclosed quote '\\'' at position %d", i)
+			}
+
+			arg.WriteString(s[i+1 : i+1+end])
+			inWord = true
+			i += end + 2
+		case c == '"':
+			start, closed := i, false
+			for i++; i < len(s); i++ {
+				c := s[i]
+				if c == '"' {
+					closed = true
+					i++
+					break
+				}
+
+				if c == '\\' && i+1 < len(s) &&
+					strings.IndexByte("$`\"\\\n", s[i+1]) >= 0 {
+					i++
+					if s[i] != '\n' {
+						arg.WriteByte(s[i])
+					}
+					continue
+				}
+
+				arg.WriteByte(c)
+			}
+
+			if !closed {
+				return nil, fmt.Errorf("unclosed quote '\"' at position %d",
+					start)
+			}
+			inWord = true
+		default:
+			arg.WriteByte(c)
+			inWord = true
+			i++
+		}
+	}
+
+	if inWord {
+		args = append(args, arg.String())
+	}
+
+	return args, nil
+}
+
+// The isShellSafe function checks if the string
+// doesn't need quoting in the shell.
+func isShellSafe(s string) bool {
+	for i := 0; i < len(s); i++ {
+		if !isAlnum(s[i]) && strings.IndexByte("@%+=:,./_-", s[i]) < 0 {
+			return false
+		}
+	}
+
+	return s != ""
+}
+
+// ShellJoin joins the arguments into a command line, quoting them
+// for a POSIX shell when needed, so that the ShellSplit function
+// and the shell return the same arguments.
+//
+// Arguments with special characters are enclosed in single quotes,
+// a single quote inside them is written as '"'"'.
+//
+// Example usage:
+//
+//	g.ShellJoin([]string{"echo", "two words", "it's", ""})
+//	// Output: `echo 'two words' 'it'"'"'s' ''`
+func ShellJoin(args []string) string {
+	quoted := make([]string, len(args))
+	for i, arg := range args {
+		if isShellSafe(arg) {
+			quoted[i] = arg
+		} else {
+			quoted[i] = "'" + strings.ReplaceAll(arg, "'", `'"'"'`) + "'"
+		}
+	}
+
+	return strings.Join(quoted, " ")
+}
@@ -0,0 +1,229 @@
+package g
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+)
+
+// TestSplitQuoted tests the SplitQuoted function.
+func TestSplitQuoted(t *testing.T) {
+	tests := []struct {
+		name string
+		s    string
+		opts []SplitOptions
+		want []string
+	}{
+		{"Default", `a,"b,c",d`, nil, []string{"a", "b,c", "d"}},
+		{"Empty string", "", nil, []string{""}},
+		{"Empty fields", "a,,b,", nil, []string{"a", "", "b", ""}},
+		{"No trim", `a, "b, c" , d`, nil, []string{"a", " b, c ", " d"}},
+		{
+			"Trim",
+			`a, "b, c" , d`,
+			[]SplitOptions{{TrimSpace: true}},
+			[]string{"a", "b, c", "d"},
+		},
+		{
+			"Trim keeps quoted space",
+			`" a ",  b  `,
+			[]SplitOptions{{TrimSpace: true}},
+			[]string{" a ", "b"},
+		},
+		{
+			"Skip empty",
+			"a,, ,b,",
+			[]SplitOptions{{TrimSpace: true, SkipEmpty: true}},
+			[]string{"a", "b"},
+		},
+		{
+			"Skip empty keeps quoted",
+			`a,"",b`,
+			[]SplitOptions{{SkipEmpty: true}},
+			[]string{"a", "", "b"},
+		},
+		{
+			"Skip empty in empty string",
+			"",
+			[]SplitOptions{{SkipEmpty: true}},
+			[]string{},
+		},
+		{
+			"Several separators",
+			"a;b|c;d",
+			[]SplitOptions{{Separators: ";|"}},
+			[]string{"a", "b", "c", "d"},
+		},
+		{
+			"Whitespace separators",
+			"cmd  --flag\t'two words'",
+			[]SplitOptions{{
+				Separators: Whitespaces,
+				Quotes:     `"'`,
+				SkipEmpty:  true,
+			}},
+			[]string{"cmd", "--flag", "two words"},
+		},
+		{
+			"Several quotes",
+			`'a,"b"',"c,'d'"`,
+			[]SplitOptions{{Quotes: `"'`}},
+			[]string{`a,"b"`, `c,'d'`},
+		},
+		{
+			"Partly quoted",
+			`name="John, Jr.",age=30`,
+			nil,
+			[]string{"name=John, Jr.", "age=30"},
+		},
+		{
+			"Keep quotes",
+			`a,"b,c"`,
+			[]SplitOptions{{KeepQuotes: true}},
+			[]string{"a", `"b,c"`},
+		},
+		{
+			"Escape",
+			`a\,b,c\\,d`,
+			[]SplitOptions{{Escape: '\\'}},
+			[]string{"a,b", `c\`, "d"},
+		},
+		{
+			"Escape in quotes",
+			`"a\"b",c`,
+			[]SplitOptions{{Escape: '\\'}},
+			[]string{`a"b`, "c"},
+		},
+		{
+			"Escaped space is kept",
+			`a\ ,b`,
+			[]SplitOptions{{Escape: '\\', TrimSpace: true}},
+			[]string{"a ", "b"},
+		},
+		{
+			"Unicode quotes",
+			"„Київ; Львів„; Одеса",
+			[]SplitOptions{{Separators: ";", Quotes: "„", TrimSpace: true}},
+			[]string{"Київ; Львів", "Одеса"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := SplitQuoted(tt.s, tt.opts...)
+			if err != nil {
+				t.Fatalf("SplitQuoted() error = %v", err)
+			}
+
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("SplitQuoted() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+// TestSplitQuotedErrors tests the errors of the SplitQuoted function.
+func TestSplitQuotedErrors(t *testing.T) {
+	tests := []struct {
+		name string
+		s    string
+		opts SplitOptions
+		want string
+	}{
+		{"Unclosed quote", `a,"b,c`, SplitOptions{}, "unclosed quote '\"' at position 2"},
+		{"Unclosed other quote", `a,'b`, SplitOptions{Quotes: `'"`}, "position 2"},
+		{"Trailing escape", `a,b\`, SplitOptions{Escape: '\\'}, "escape character"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := SplitQuoted(tt.s, tt.opts)
+			if err == nil || !strings.Contains(err.Error(), tt.want) {
+				t.Errorf("SplitQuoted() error = %v, want %q", err, tt.want)
+			}
+		})
+	}
+}
+
+// TestShellSplit tests the ShellSplit function.
+func TestShellSplit(t *testing.T) {
+	tests := []struct {
+		name string
+		s    string
+		want []string
+	}{
+		{"Empty", "", []string{}},
+		{"Spaces", " \t\n ", []string{}},
+		{"Simple", "ls -la /tmp", []string{"ls", "-la", "/tmp"}},
+		{"Double quotes", `cmd --flag "two words"`,
+			[]string{"cmd", "--flag", "two words"}},
+		{"Single quotes", `echo 'a "b" $c \d'`, []string{"echo", `a "b" $c \d`}},
+		{"Adjacent quotes", `'it'"'"'s' a"b"'c'`, []string{"it's", "abc"}},
+		{"Empty quotes", `a '' ""`, []string{"a", "", ""}},
+		{"Escaped space", `a\ b c`, []string{"a b", "c"}},
+		{"Escapes in double quotes", `"\$HOME \"x\" \\ \n"`,
+			[]string{`$HOME "x" \ \n`}},
+		{"Line continuation", "a \\\nb", []string{"a", "b"}},
+		{"Continuation in word", "ab\\\ncd", []string{"abcd"}},
+		{"Comment", "a # comment\nb", []string{"a", "b"}},
+		{"Hash in word", "a#b '#c'", []string{"a#b", "#c"}},
+		{"Unicode", `echo "Привіт, світ" ✓`,
+			[]string{"echo", "Привіт, світ", "✓"}},
+		{"Escaped unicode", `\ї`, []string{"ї"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := ShellSplit(tt.s)
+			if err != nil {
+				t.Fatalf("ShellSplit() error = %v", err)
+			}
+
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("ShellSplit() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+
+	for _, s := range []string{`a "b`, `a 'b`, `a b\`} {
+		if _, err := ShellSplit(s); err == nil {
+			t.Errorf("ShellSplit(%q) error = nil, want error", s)
+		}
+	}
+}
+
+// TestShellJoin tests the ShellJoin function
+// and the round trip with the ShellSplit function.
+func TestShellJoin(t *testing.T) {
+	args := []string{"echo", "two words", "it's", ""}
+	want := `echo 'two words' 'it'"'"'s' ''`
+	if got := ShellJoin(args); got != want {
+		t.Errorf("ShellJoin() = %s, want %s", got, want)
+	}
+
+	if got := ShellJoin(nil); got != "" {
+		t.Errorf("ShellJoin(nil) = %q, want empty string", got)
+	}
+
+	tests := [][]string{
+		{"ls", "-la", "/tmp/my dir"},
+		{"grep", "-e", `a\b`, `"quoted"`, "$HOME", "`cmd`"},
+		{"printf", "line1\nline2\t!", "#not-comment", "~", "*.go"},
+		{"echo", "'", "''", `'"'"'`, " ", ""},
+		{"key=value", "a@b.com", "1,2", "x:y", "50%", "+1"},
+		{"Привіт", "світ ✓", "👍"},
+	}
+
+	for _, args := range tests {
+		line := ShellJoin(args)
+		got, err := ShellSplit(line)
+		if err != nil {
+			t.Errorf("ShellSplit(%s) error = %v", line, err)
+			continue
+		}
+
+		if !reflect.DeepEqual(got, args) {
+			t.Errorf("ShellSplit(ShellJoin(%q)) = %q", args, got)
+		}
+	}
+}
@@ -1,6 +1,10 @@
 package g
 
-import "strings"
+import (
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
 
 const (
 	// Numbers is a string of all numbers.
@@ -28,40 +32,134 @@ const (
 	Hidden = "\t\b\n\r\v\f"
 )
 
-// The filterOrPreserveChars is a helper function that filters or preserves
-// characters in a string based on a set of provided patterns. It takes in
-// a string `s`, a  boolean `i` that determines whether to filter or preserve
-// characters,  a default set of patterns `d`, and optional patterns `p`.
-// If `i` is true, it preserves only the characters in the patterns;
-// if `i` is false, it filters out the characters in the patterns.
-// By default, if no optional patterns are provided, it uses the default
-// set `d`. The function employs a map for efficient character lookup,
-// and a strings.Builder to efficiently build the result string.
-// It iterates over the input string and for each rune, it checks if the rune
-// exists in the map. Depending on the value of `i`, it either writes the rune
-// to the result string (if preserving) or skips it (if filtering).
+// CommonInitialisms is a list of common initialisms used in Go names,
+// it can be passed to the CamelCase, PascalCase and TitleCase functions
+// to keep them in uppercase, e.g. "UserID" instead of "UserId", and to
+// the SnakeCase, KebabCase and ScreamingSnake functions to keep them
+// as single words.
+var CommonInitialisms = []string{
+	"ACL", "API", "ASCII", "CPU", "CSS", "DNS", "EOF", "GUID", "HTML",
+	"HTTP", "HTTPS", "ID", "IP", "JSON", "LHS", "QPS", "RAM", "RHS",
+	"RPC", "SLA", "SMTP", "SQL", "SSH", "TCP", "TLS", "TTL", "UDP",
+	"UI", "UID", "UUID", "URI", "URL", "UTF8", "VM", "XML", "XMPP",
+	"XSRF", "XSS",
+}
+
+// The runeSet is a compiled set of characters of the Weed, Preserve and
+// Trim patterns. ASCII characters are stored in a 128-bit bitset, other
+// characters in a map that is created only for non-ASCII patterns.
+type runeSet struct {
+	ascii [2]uint64
+	other map[rune]struct{}
+}
+
+// The precompiled default sets of the Weed, Preserve and Trim functions.
+var (
+	weedDefaultSet     = newRuneSet(Hidden)
+	preserveDefaultSet = newRuneSet(Letters, Numbers, " ")
+	trimDefaultSet     = newRuneSet(Whitespaces, Breakers)
+)
+
+// The newRuneSet function compiles the characters of the patterns.
+func newRuneSet(patterns ...string) runeSet {
+	var rs runeSet
+	for _, pattern := range patterns {
+		for _, r := range pattern {
+			if r < utf8.RuneSelf {
+				rs.ascii[r>>6] |= 1 << (r & 63)
+				continue
+			}
+
+			if rs.other == nil {
+				rs.other = make(map[rune]struct{})
+			}
+			rs.other[r] = struct{}{}
+		}
+	}
+
+	return rs
+}
+
+// The hasByte method checks if the byte is an ASCII character of the set.
+func (rs *runeSet) hasByte(c byte) bool {
+	return c < utf8.RuneSelf && rs.ascii[c>>6]&(1<<(c&63)) != 0
+}
+
+// The contains method checks if the rune is in the set.
+func (rs *runeSet) contains(r rune) bool {
+	if r < utf8.RuneSelf {
+		return r >= 0 && rs.hasByte(byte(r))
+	}
+
+	_, ok := rs.other[r]
+	return ok
+}
+
+// The filterOrPreserveChars function removes the characters of the set
+// from the string, or keeps only them if the keep flag is set.
+//
+// If the set contains only ASCII characters and the string is valid
+// UTF-8, the string is processed byte by byte: bytes of multibyte
+// characters are never in the set, so they are all kept (or all removed)
+// without decoding. The string is returned as is, without allocation,
+// if nothing has to be removed.
+//
+// Each byte of invalid UTF-8 is treated as the U+FFFD replacement
+// character, as range over the string does, so it's written as U+FFFD
+// if it's kept.
 //
 // This function is not exported and is used internally by the Weed and
 // Preserve functions to reduce code duplication and improve maintenance.
-func filterOrPreserveChars(s string, i bool, d []string, p ...string) string {
-	var sb strings.Builder
+func filterOrPreserveChars(s string, keep bool, set *runeSet) string {
+	if set.other == nil && utf8.ValidString(s) {
+		// The prefix that stays unchanged.
+		i := 0
+		for i < len(s) && set.hasByte(s[i]) == keep {
+			i++
+		}
+
+		if i == len(s) {
+			return s
+		}
+
+		var sb strings.Builder
+		sb.Grow(len(s) - 1)
+		sb.WriteString(s[:i])
+		for ; i < len(s); i++ {
+			if set.hasByte(s[i]) == keep {
+				sb.WriteByte(s[i])
+			}
+		}
 
-	if len(p) == 0 {
-		p = d
+		return sb.String()
 	}
 
-	// Characters as a map
-	cm := make(map[rune]struct{})
-	for _, pattern := range p {
-		for _, ch := range pattern {
-			cm[ch] = struct{}{}
+	i := 0
+	for i < len(s) {
+		r, size := utf8.DecodeRuneInString(s[i:])
+		if set.contains(r) != keep || r == utf8.RuneError && size == 1 {
+			break
 		}
+		i += size
 	}
 
-	for _, r := range s {
-		if _, ok := cm[r]; (i && ok) || (!i && !ok) {
-			sb.WriteRune(r)
+	if i == len(s) {
+		return s
+	}
+
+	var sb strings.Builder
+	sb.Grow(len(s))
+	sb.WriteString(s[:i])
+	for i < len(s) {
+		r, size := utf8.DecodeRuneInString(s[i:])
+		if set.contains(r) == keep {
+			if r == utf8.RuneError && size == 1 {
+				sb.WriteRune(r)
+			} else {
+				sb.WriteString(s[i : i+size])
+			}
 		}
+		i += size
 	}
 
 	return sb.String()
@@ -77,9 +175,12 @@ func filterOrPreserveChars(s string, i bool, d []string, p ...string) string {
 //
 // By default, if no patterns are specified, it removes the most common
 // breakers characters (e.g. newline, tab etc.). However, you can specify
-// your own patterns to fit your needs. The function uses an efficient
-// mapping approach to achieve this, making it effective for clearing
-// large strings.
+// your own patterns to fit your needs. ASCII patterns are compiled into
+// a bitset and ASCII input is processed byte by byte, making it effective
+// for clearing large strings; if there is nothing to remove, the string
+// is returned without allocation. Use the Weed method of a CharSet
+// compiled by the Chars function for ranges, negation and classes,
+// e.g. g.Chars("^a-z0-9").Weed(s).
 //
 // Example usage:
 //
@@ -87,12 +188,12 @@ func filterOrPreserveChars(s string, i bool, d []string, p ...string) string {
 //	g.Weed(" i@ goloop.one", g.Whitespaces)  // Output: "i@goloop.one"
 //	g.Weed("+380 (96) 123 4567", " +()")     // Output: "380961234567"
 func Weed(s string, patterns ...string) string {
-	return filterOrPreserveChars(
-		s,
-		false,
-		[]string{Hidden},
-		patterns...,
-	)
+	if len(patterns) == 0 {
+		return filterOrPreserveChars(s, false, &weedDefaultSet)
+	}
+
+	set := newRuneSet(patterns...)
+	return filterOrPreserveChars(s, false, &set)
 }
 
 // Trim removes all leading and trailing occurrences of specified characters
@@ -102,8 +203,11 @@ func Weed(s string, patterns ...string) string {
 // It can be used to tidy up user input or to normalize strings for
 // consistent processing.
 //
-// The function utilizes the TrimFunc function from the standard strings
-// package, which makes it efficient for clearing large strings.
+// ASCII patterns are compiled into a bitset and checked byte by byte,
+// the result is a substring of the original string, so the function
+// doesn't allocate memory. Use the Trim method of a CharSet compiled
+// by the Chars function for ranges, negation and classes,
+// e.g. g.Chars("[:punct:]").Trim(s).
 //
 // Example usage:
 //
@@ -111,22 +215,27 @@ func Weed(s string, patterns ...string) string {
 //	g.Trim("    Go Loop   ")                  // Output: "Go Loop"
 //	g.Trim(" i@ goloop.one ", g.Whitespaces)  // Output: "i@ goloop.one"
 func Trim(s string, patterns ...string) string {
-	if len(patterns) == 0 {
-		patterns = []string{Whitespaces, Breakers}
+	set := &trimDefaultSet
+	if len(patterns) != 0 {
+		compiled := newRuneSet(patterns...)
+		set = &compiled
 	}
 
-	// Characters to be deleted.
-	cbd := make(map[rune]struct{})
-	for _, pattern := range patterns {
-		for _, ch := range pattern {
-			cbd[ch] = struct{}{}
-		}
+	if set.other != nil {
+		return strings.TrimFunc(s, set.contains)
 	}
 
-	return strings.TrimFunc(s, func(r rune) bool {
-		_, exists := cbd[r]
-		return exists
-	})
+	// Bytes of multibyte characters are never in the ASCII set.
+	start, end := 0, len(s)
+	for start < end && set.hasByte(s[start]) {
+		start++
+	}
+
+	for end > start && set.hasByte(s[end-1]) {
+		end--
+	}
+
+	return s[start:end]
 }
 
 // Preserve keeps only characters specified by the patterns in the string.
@@ -138,19 +247,325 @@ func Trim(s string, patterns ...string) string {
 //
 // By default, if no patterns are specified, it keeps only alphanumeric
 // characters (i.e., letters, numbers and space). However, you can specify
-// your own patterns to fit your needs. The function uses an efficient
-// mapping approach to achieve this, making it effective for processing
-// large strings.
+// your own patterns to fit your needs. As in the Weed function, ASCII
+// patterns are compiled into a bitset, making it effective for processing
+// large strings; if all characters are kept, the string is returned
+// without allocation. Use the Preserve method of a CharSet compiled
+// by the Chars function for ranges, negation and classes,
+// e.g. g.Chars("a-zA-Z0-9_-").Preserve(s).
 //
 // Example usage:
 //
 //	g.Preserve("Hello, World!")                 // Output: "Hello World"
 //	g.Preserve("+380 (96) 123 4567", g.Numbers) // Output: "380961234567"
 func Preserve(s string, patterns ...string) string {
-	return filterOrPreserveChars(
-		s,
-		true,
-		[]string{Letters, Numbers, " "},
-		patterns...,
-	)
+	if len(patterns) == 0 {
+		return filterOrPreserveChars(s, true, &preserveDefaultSet)
+	}
+
+	set := newRuneSet(patterns...)
+	return filterOrPreserveChars(s, true, &set)
+}
+
+// WeedFunc removes characters that match the patterns from the whole
+// string, as the Weed function does, but it also supports Unicode-aware
+// patterns.
+//
+// Each pattern can be a string (a list of characters, as in Weed),
+// a rune, a func(rune) bool predicate (e.g. g.UnicodeLetters,
+// g.UnicodeDigits, g.UnicodeSpace, g.Punctuation, g.Emoji or
+// unicode.IsUpper), a *unicode.RangeTable (e.g. unicode.Cyrillic)
+// or a *CharSet compiled by the Chars function (e.g. g.Chars("^a-z")).
+// Patterns of different kinds can be mixed. The function panics
+// if a pattern has an unsupported type.
+//
+// By default, if no patterns are specified, it removes the Hidden
+// characters, as the Weed function does.
+//
+// Example usage:
+//
+//	g.WeedFunc("Привіт, світ! 👋", g.Punctuation, g.Emoji)
+//	// Output: "Привіт світ "
+//	g.WeedFunc("Go: Го", unicode.Cyrillic, ":")  // Output: "Go "
+//	g.WeedFunc("+380 (96) 123", g.Chars("^0-9"))  // Output: "38096123"
+func WeedFunc(s string, patterns ...any) string {
+	if len(patterns) == 0 {
+		patterns = []any{Hidden}
+	}
+
+	match := runeMatcher(patterns)
+	return strings.Map(func(r rune) rune {
+		return If(match(r), -1, r)
+	}, s)
+}
+
+// TrimFunc removes all leading and trailing characters that match
+// the patterns, as the Trim function does, but it also supports
+// Unicode-aware patterns (see WeedFunc for the supported pattern kinds).
+//
+// By default, if no patterns are specified, it removes leading and
+// trailing Unicode white space, including the non-breaking space.
+//
+// Example usage:
+//
+//	g.TrimFunc("\u00a0 «Привіт» \n")       // Output: "«Привіт»"
+//	g.TrimFunc("«Привіт»!", g.Punctuation)  // Output: "Привіт"
+//	g.TrimFunc("123abc456", unicode.Digit)  // Output: "abc"
+func TrimFunc(s string, patterns ...any) string {
+	if len(patterns) == 0 {
+		patterns = []any{UnicodeSpace}
+	}
+
+	return strings.TrimFunc(s, runeMatcher(patterns))
+}
+
+// PreserveFunc keeps only characters that match the patterns
+// in the string, as the Preserve function does, but it also supports
+// Unicode-aware patterns (see WeedFunc for the supported pattern kinds).
+//
+// By default, if no patterns are specified, it keeps letters and digits
+// of any script and the space, i.e. the Unicode counterpart
+// of the Preserve function defaults.
+//
+// Example usage:
+//
+//	g.PreserveFunc("Привіт, світ!")                 // Output: "Привіт світ"
+//	g.PreserveFunc("Ціна: 100 ₴", g.UnicodeDigits)  // Output: "100"
+//	g.PreserveFunc("Go — Го", unicode.Latin, " ")   // Output: "Go  "
+func PreserveFunc(s string, patterns ...any) string {
+	if len(patterns) == 0 {
+		patterns = []any{UnicodeLetters, UnicodeDigits, " "}
+	}
+
+	match := runeMatcher(patterns)
+	return strings.Map(func(r rune) rune {
+		return If(match(r), r, -1)
+	}, s)
+}
+
+// The isPluralSuffix function checks if the rune with the index i is
+// the plural "s" of the preceding acronym or initialism ("IDs", "URLs"),
+// i.e. a lowercase "s" at the end of the word.
+func isPluralSuffix(rs []rune, i int) bool {
+	return i < len(rs) && rs[i] == 's' &&
+		(i+1 == len(rs) || !unicode.IsLower(rs[i+1]))
+}
+
+// The matchInitialism function returns the length of the longest
+// initialism (as is or in uppercase, with the optional plural "s")
+// at the beginning of the runes that is followed by the end of the
+// runes or an uppercase letter, or 0 if there is no such initialism.
+func matchInitialism(rs []rune, initialisms []string) int {
+	longest := 0
+	for _, initialism := range initialisms {
+		for _, form := range []string{initialism, strings.ToUpper(initialism)} {
+			irs := []rune(form)
+			n := len(irs)
+			if n <= longest || n > len(rs) || string(rs[:n]) != form {
+				continue
+			}
+
+			if isPluralSuffix(rs, n) {
+				n++
+			}
+
+			if n == len(rs) || unicode.IsUpper(rs[n]) {
+				longest = n
+			}
+		}
+	}
+
+	return longest
+}
+
+// The splitWords function splits the identifier into words.
+//
+// Any character that is not a letter or a digit separates words.
+// A new word also starts at an uppercase letter that follows a lowercase
+// letter or a digit ("userName", "base64Encode") and at the last letter
+// of an acronym followed by a lowercase letter ("HTTPServer"), unless
+// it's the plural "s" of the acronym ("userIDs").
+//
+// The initialisms are kept as single words, even if they have mixed
+// case ("GraphQLSchema" with "GraphQL") or are followed by another
+// acronym ("XMLHTTPRequest" with "XML" and "HTTP").
+func splitWords(s string, initialisms []string) []string {
+	var words []string
+	for _, field := range strings.FieldsFunc(s, func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsDigit(r)
+	}) {
+		rs := []rune(field)
+		for start := 0; start < len(rs); {
+			end := start + matchInitialism(rs[start:], initialisms)
+			if end == start {
+				for end = start + 1; end < len(rs); end++ {
+					if !unicode.IsUpper(rs[end]) {
+						continue
+					}
+
+					prev := rs[end-1]
+					if unicode.IsLower(prev) || unicode.IsDigit(prev) ||
+						unicode.IsUpper(prev) && end+1 < len(rs) &&
+							unicode.IsLower(rs[end+1]) &&
+							!isPluralSuffix(rs, end+1) {
+						break
+					}
+				}
+			}
+
+			words = append(words, string(rs[start:end]))
+			start = end
+		}
+	}
+
+	return words
+}
+
+// The capitalize function converts the first letter of the word
+// to uppercase and the others to lowercase. The word is converted to
+// uppercase if it is one of the initialisms, the plural "s" of the
+// initialism stays lowercase ("IDs").
+func capitalize(word string, initialisms []string) string {
+	upper := strings.ToUpper(word)
+	for _, initialism := range initialisms {
+		if strings.ToUpper(initialism) == upper {
+			return upper
+		}
+	}
+
+	// The plural form is checked only if there is no exact match,
+	// e.g. "HTTPS" is an initialism, not the plural of "HTTP".
+	for _, initialism := range initialisms {
+		initialism = strings.ToUpper(initialism)
+		if upper == initialism+"S" {
+			return initialism + "s"
+		}
+	}
+
+	rs := []rune(strings.ToLower(word))
+	rs[0] = unicode.ToUpper(rs[0])
+	return string(rs)
+}
+
+// The joinWords function joins the words of the identifier by
+// the separator, converting each word by the function.
+func joinWords(
+	s, sep string,
+	initialisms []string,
+	fn func(i int, word string) string,
+) string {
+	words := splitWords(s, initialisms)
+	for i, word := range words {
+		words[i] = fn(i, word)
+	}
+
+	return strings.Join(words, sep)
+}
+
+// CamelCase converts the string to camelCase.
+//
+// Words are split on separators, on case changes and at the end
+// of acronyms (see SnakeCase). The first word is lowercase, the others
+// are capitalized; words from the optional list of initialisms are
+// kept together and in uppercase, except for the first word.
+//
+// Example usage:
+//
+//	g.CamelCase("user_name")     // Output: "userName"
+//	g.CamelCase("HTTPServer")    // Output: "httpServer"
+//	g.CamelCase("user-id", "ID") // Output: "userID"
+//
+//	g.CamelCase("api_url", g.CommonInitialisms...)
+//	// Output: "apiURL"
+func CamelCase(s string, initialisms ...string) string {
+	return joinWords(s, "", initialisms, func(i int, word string) string {
+		if i == 0 {
+			return strings.ToLower(word)
+		}
+
+		return capitalize(word, initialisms)
+	})
+}
+
+// PascalCase converts the string to PascalCase.
+//
+// Words are split as in the CamelCase function, each word is
+// capitalized; words from the optional list of initialisms are
+// kept in uppercase.
+//
+// Example usage:
+//
+//	g.PascalCase("user_name")    // Output: "UserName"
+//	g.PascalCase("привіт світ")  // Output: "ПривітСвіт"
+//
+//	g.PascalCase("json_api_url", g.CommonInitialisms...)
+//	// Output: "JSONAPIURL"
+func PascalCase(s string, initialisms ...string) string {
+	return joinWords(s, "", initialisms, func(_ int, word string) string {
+		return capitalize(word, initialisms)
+	})
+}
+
+// SnakeCase converts the string to snake_case.
+//
+// Any character that is not a letter or a digit separates words,
+// a new word also starts at a case change and at the end of an acronym,
+// so acronyms are kept together, with their plural "s" ("userIDs").
+// Digits stay with the preceding word. Words from the optional list
+// of initialisms are never split, e.g. "GraphQL".
+//
+// Example usage:
+//
+//	g.SnakeCase("userName")      // Output: "user_name"
+//	g.SnakeCase("HTTPServer")    // Output: "http_server"
+//	g.SnakeCase("base64Encode")  // Output: "base64_encode"
+//	g.SnakeCase("Hello, World")  // Output: "hello_world"
+//	g.SnakeCase("userIDs")       // Output: "user_ids"
+//
+//	g.SnakeCase("GraphQLSchema", "GraphQL")  // Output: "graphql_schema"
+func SnakeCase(s string, initialisms ...string) string {
+	return joinWords(s, "_", initialisms, func(_ int, word string) string {
+		return strings.ToLower(word)
+	})
+}
+
+// KebabCase converts the string to kebab-case. Words are split
+// as in the SnakeCase function, with the optional list of initialisms.
+//
+// Example usage:
+//
+//	g.KebabCase("userName")    // Output: "user-name"
+//	g.KebabCase("HTTPServer")  // Output: "http-server"
+func KebabCase(s string, initialisms ...string) string {
+	return joinWords(s, "-", initialisms, func(_ int, word string) string {
+		return strings.ToLower(word)
+	})
+}
+
+// ScreamingSnake converts the string to SCREAMING_SNAKE_CASE,
+// e.g. for names of constants and environment variables. Words are split
+// as in the SnakeCase function, with the optional list of initialisms.
+//
+// Example usage:
+//
+//	g.ScreamingSnake("userName")    // Output: "USER_NAME"
+//	g.ScreamingSnake("http-server") // Output: "HTTP_SERVER"
+func ScreamingSnake(s string, initialisms ...string) string {
+	return joinWords(s, "_", initialisms, func(_ int, word string) string {
+		return strings.ToUpper(word)
+	})
+}
+
+// TitleCase converts the string to Title Case: words are split as in
+// the SnakeCase function, capitalized and joined by spaces; words from
+// the optional list of initialisms are kept in uppercase.
+//
+// Example usage:
+//
+//	g.TitleCase("user_name")           // Output: "User Name"
+//	g.TitleCase("httpServer", "HTTP")  // Output: "HTTP Server"
+func TitleCase(s string, initialisms ...string) string {
+	return joinWords(s, " ", initialisms, func(_ int, word string) string {
+		return capitalize(word, initialisms)
+	})
 }
@@ -2,6 +2,7 @@ package g
 
 import (
 	"testing"
+	"unicode"
 )
 
 // TestWeed tests the Weed function.
@@ -30,6 +31,30 @@ func TestWeed(t *testing.T) {
 			patterns: []string{Whitespaces, Breakers},
 			want:     "i@goloop.one",
 		},
+		{
+			name:     "Nothing to weed",
+			s:        "Hello World",
+			patterns: []string{},
+			want:     "Hello World",
+		},
+		{
+			name:     "Non-ASCII input",
+			s:        "Привіт,\tсвіт! 👋",
+			patterns: []string{Hidden, "!,"},
+			want:     "Привітсвіт 👋",
+		},
+		{
+			name:     "Non-ASCII pattern",
+			s:        "Привіт, світ!",
+			patterns: []string{"іт", ","},
+			want:     "Прив св!",
+		},
+		{
+			name:     "Weed everything",
+			s:        "\t\n",
+			patterns: []string{},
+			want:     "",
+		},
 	}
 
 	for _, tt := range tests {
@@ -67,6 +92,24 @@ func TestTrim(t *testing.T) {
 			patterns: []string{Whitespaces, Breakers},
 			want:     "i@goloop.one",
 		},
+		{
+			name:     "Non-ASCII input",
+			s:        " «Привіт» ",
+			patterns: []string{},
+			want:     "«Привіт»",
+		},
+		{
+			name:     "Non-ASCII pattern",
+			s:        "«Привіт»",
+			patterns: []string{"«»"},
+			want:     "Привіт",
+		},
+		{
+			name:     "Trim everything",
+			s:        " \t\n ",
+			patterns: []string{},
+			want:     "",
+		},
 	}
 
 	for _, tt := range tests {
@@ -109,6 +152,18 @@ func TestPreserve(t *testing.T) {
 			patterns: []string{Symbols},
 			expected: ",!",
 		},
+		{
+			name:     "Non-ASCII input",
+			input:    "Ціна: 100 ₴",
+			patterns: []string{Numbers},
+			expected: "100",
+		},
+		{
+			name:     "Non-ASCII pattern",
+			input:    "Ціна: 100 ₴",
+			patterns: []string{Numbers, "₴ "},
+			expected: " 100 ₴",
+		},
 	}
 
 	for _, tc := range testCases {
@@ -121,3 +176,245 @@ func TestPreserve(t *testing.T) {
 		})
 	}
 }
+
+// TestFilterAllocations tests that the Weed, Preserve and Trim functions
+// with ASCII patterns don't allocate memory if the string doesn't change.
+func TestFilterAllocations(t *testing.T) {
+	tests := []struct {
+		name string
+		fn   func()
+	}{
+		{"Weed", func() { _ = Weed("Hello, World") }},
+		{"Weed custom", func() { _ = Weed("Привіт, світ", Hidden) }},
+		{"Preserve", func() { _ = Preserve("Hello World 42") }},
+		{"Trim", func() { _ = Trim("  Hello, World\n") }},
+		{"Trim custom", func() { _ = Trim("--Hello--", "-") }},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if n := testing.AllocsPerRun(100, tt.fn); n != 0 {
+				t.Errorf("%s allocates %v times, want 0", tt.name, n)
+			}
+		})
+	}
+}
+
+// TestFilterInvalidUTF8 tests that the Weed and Preserve functions
+// replace invalid UTF-8 bytes they keep with U+FFFD.
+func TestFilterInvalidUTF8(t *testing.T) {
+	tests := []struct {
+		name string
+		got  string
+		want string
+	}{
+		{"Weed ASCII pattern", Weed("a\xffb,c", ","), "a\ufffdbc"},
+		{"Weed default", Weed("a\xff\tb"), "a\ufffdb"},
+		{"Weed unicode pattern", Weed("і\xffb", "b"), "і\ufffd"},
+		{"Weed replacement", Weed("a\xffb", "\ufffd"), "ab"},
+		{"Preserve", Preserve("a\xff\xfeb", "ab"), "ab"},
+		{"Preserve replacement", Preserve("a\xffb", "a\ufffd"),
+			"a\ufffd"},
+		{"Truncated sequence", Weed("a\xd0", "-"), "a\ufffd"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if tt.got != tt.want {
+				t.Errorf("got %q, want %q", tt.got, tt.want)
+			}
+		})
+	}
+}
+
+// TestWeedFunc tests the WeedFunc function.
+func TestWeedFunc(t *testing.T) {
+	tests := []struct {
+		name     string
+		s        string
+		patterns []any
+		want     string
+	}{
+		{"Default", "Привіт\tсвіт\n", nil, "Привітсвіт"},
+		{"Punctuation", "«Привіт», світе!", []any{Punctuation},
+			"Привіт світе"},
+		{"Mixed", "Привіт, світ! 👋", []any{Punctuation, Emoji},
+			"Привіт світ "},
+		{"Range table", "Go: Го", []any{unicode.Cyrillic, ":"}, "Go "},
+		{"Rune", "a-b-c", []any{'-'}, "abc"},
+		{"Predicate", "Hello World", []any{unicode.IsUpper}, "ello orld"},
+		{"Flag", "Slava 🇺🇦!", []any{Emoji, Whitespaces}, "Slava!"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := WeedFunc(tt.s, tt.patterns...); got != tt.want {
+				t.Errorf("WeedFunc() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+
+	defer func() {
+		if recover() == nil {
+			t.Errorf("WeedFunc() expected panic for unsupported pattern")
+		}
+	}()
+	WeedFunc("abc", 42)
+}
+
+// TestTrimFunc tests the TrimFunc function.
+func TestTrimFunc(t *testing.T) {
+	tests := []struct {
+		name     string
+		s        string
+		patterns []any
+		want     string
+	}{
+		{"Default", "\u00a0 «Привіт» \n", nil, "«Привіт»"},
+		{"Punctuation", "«Привіт»!", []any{Punctuation}, "Привіт"},
+		{"Range table", "123abc456", []any{unicode.Digit}, "abc"},
+		{"Mixed", "__Привіт__", []any{"_", UnicodeSpace}, "Привіт"},
+		{"Emoji", "🎉 Party 🎉", []any{Emoji, UnicodeSpace}, "Party"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := TrimFunc(tt.s, tt.patterns...); got != tt.want {
+				t.Errorf("TrimFunc() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+// TestPreserveFunc tests the PreserveFunc function.
+func TestPreserveFunc(t *testing.T) {
+	tests := []struct {
+		name     string
+		s        string
+		patterns []any
+		want     string
+	}{
+		{"Default", "Привіт, світ!", nil, "Привіт світ"},
+		{"Letters", "Привіт, світ!", []any{UnicodeLetters, " "},
+			"Привіт світ"},
+		{"Digits", "Ціна: 100 ₴, ٣", []any{UnicodeDigits}, "100٣"},
+		{"Range table", "Go — Го", []any{unicode.Latin, " "}, "Go  "},
+		{"Mixed with constants", "Tel: +38 (096) 1-2", []any{Numbers, "+"},
+			"+3809612"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := PreserveFunc(tt.s, tt.patterns...); got != tt.want {
+				t.Errorf("PreserveFunc() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+// TestSplitWords tests the splitWords function.
+func TestSplitWords(t *testing.T) {
+	tests := []struct {
+		s           string
+		initialisms []string
+		want        []string
+	}{
+		{"userName", nil, []string{"user", "Name"}},
+		{"HTTPServer", nil, []string{"HTTP", "Server"}},
+		{"getHTTPResponseCode", nil, []string{"get", "HTTP", "Response", "Code"}},
+		{"base64Encode", nil, []string{"base64", "Encode"}},
+		{"HTTP2Server", nil, []string{"HTTP2", "Server"}},
+		{"user_id", nil, []string{"user", "id"}},
+		{"  --hello   world-- ", nil, []string{"hello", "world"}},
+		{"ID", nil, []string{"ID"}},
+		{"привітСвіт", nil, []string{"привіт", "Світ"}},
+		{"", nil, nil},
+		{"userIDs", nil, []string{"user", "IDs"}},
+		{"URLsList", nil, []string{"URLs", "List"}},
+		{"HTTPSend", nil, []string{"HTTP", "Send"}},
+		{"GraphQLSchema", []string{"GraphQL"}, []string{"GraphQL", "Schema"}},
+		{"myGraphQLAPIs", []string{"GraphQL", "API"},
+			[]string{"my", "GraphQL", "APIs"}},
+		{"XMLHTTPRequest", []string{"XML", "HTTP"},
+			[]string{"XML", "HTTP", "Request"}},
+		{"IDsOfUsers", CommonInitialisms, []string{"IDs", "Of", "Users"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.s, func(t *testing.T) {
+			got := splitWords(tt.s, tt.initialisms)
+			if len(got) != len(tt.want) {
+				t.Fatalf("splitWords(%q) = %q, want %q", tt.s, got, tt.want)
+			}
+
+			for i := range got {
+				if got[i] != tt.want[i] {
+					t.Errorf("splitWords(%q) = %q, want %q", tt.s, got, tt.want)
+				}
+			}
+		})
+	}
+}
+
+// TestCaseConversion tests the CamelCase, PascalCase, SnakeCase,
+// KebabCase, ScreamingSnake and TitleCase functions.
+func TestCaseConversion(t *testing.T) {
+	tests := []struct {
+		name string
+		got  string
+		want string
+	}{
+		{"CamelCase", CamelCase("user_name"), "userName"},
+		{"CamelCase acronym", CamelCase("HTTPServer"), "httpServer"},
+		{"CamelCase initialism", CamelCase("user-id", "ID"), "userID"},
+		{"CamelCase first initialism", CamelCase("id_value", "ID"), "idValue"},
+		{"CamelCase common", CamelCase("api_url", CommonInitialisms...),
+			"apiURL"},
+		{"CamelCase from Pascal", CamelCase("UserName"), "userName"},
+		{"PascalCase", PascalCase("user_name"), "UserName"},
+		{"PascalCase JSON", PascalCase("userId"), "UserId"},
+		{"PascalCase Go", PascalCase("user_id", CommonInitialisms...),
+			"UserID"},
+		{"PascalCase Unicode", PascalCase("привіт світ"), "ПривітСвіт"},
+		{"PascalCase lowercase initialism", PascalCase("user_id", "id"),
+			"UserID"},
+		{"SnakeCase", SnakeCase("userName"), "user_name"},
+		{"SnakeCase acronym", SnakeCase("HTTPServer"), "http_server"},
+		{"SnakeCase digits", SnakeCase("base64Encode"), "base64_encode"},
+		{"SnakeCase Go", SnakeCase("UserID"), "user_id"},
+		{"SnakeCase spaces", SnakeCase("Hello, World"), "hello_world"},
+		{"SnakeCase kebab", SnakeCase("http-server"), "http_server"},
+		{"KebabCase", KebabCase("userName"), "user-name"},
+		{"KebabCase acronym", KebabCase("HTTPServer"), "http-server"},
+		{"ScreamingSnake", ScreamingSnake("userName"), "USER_NAME"},
+		{"ScreamingSnake kebab", ScreamingSnake("http-server"), "HTTP_SERVER"},
+		{"TitleCase", TitleCase("user_name"), "User Name"},
+		{"TitleCase initialism", TitleCase("httpServer", "HTTP"),
+			"HTTP Server"},
+		{"SnakeCase plural", SnakeCase("userIDs"), "user_ids"},
+		{"SnakeCase initialism", SnakeCase("GraphQLSchema", "GraphQL"),
+			"graphql_schema"},
+		{"KebabCase initialisms", KebabCase("XMLHTTPRequest", "XML", "HTTP"),
+			"xml-http-request"},
+		{"ScreamingSnake initialism", ScreamingSnake("newGraphQLAPI",
+			"GraphQL"), "NEW_GRAPHQL_API"},
+		{"PascalCase plural", PascalCase("user_ids", CommonInitialisms...),
+			"UserIDs"},
+		{"CamelCase mixed initialism", CamelCase("graphql_api", "GraphQL", "API"),
+			"graphqlAPI"},
+		{"TitleCase plural", TitleCase("listURLs", "URL"), "List URLs"},
+		{"PascalCase HTTPS", PascalCase("HTTPSServer", CommonInitialisms...),
+			"HTTPSServer"},
+		{"PascalCase HTTPS before HTTP", PascalCase("https_url", "HTTP",
+			"HTTPS", "URL"), "HTTPSURL"},
+		{"Empty", SnakeCase(""), ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if tt.got != tt.want {
+				t.Errorf("%s = %q, want %q", tt.name, tt.got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,147 @@
+package g
+
+import (
+	"encoding"
+	"fmt"
+	"reflect"
+	"strconv"
+	"time"
+)
+
+// ToString converts a value of any type to a string.
+//
+// Strings, booleans and numbers are converted with the fast strconv
+// functions; floating-point numbers use the shortest representation
+// as FloatToString does. The time.Time values are formatted with the
+// time.RFC3339Nano layout and the time.Duration values as "1h2m3s",
+// so that they can be restored by the FromString function.
+//
+// For other types, the function uses the encoding.TextMarshaler
+// interface if the value implements it, then the fmt.Stringer
+// interface, then custom types based on strings, booleans and numbers
+// are converted by their kind. All other values are formatted with
+// the fmt.Sprint function.
+//
+// Example usage:
+//
+//	g.ToString(42)                 // Output: "42"
+//	g.ToString(3.14)               // Output: "3.14"
+//	g.ToString(true)               // Output: "true"
+//	g.ToString(90 * time.Second)   // Output: "1m30s"
+//	g.ToString(net.IPv4(8, 8, 8, 8)) // Output: "8.8.8.8"
+func ToString[T any](v T) string {
+	switch val := any(v).(type) {
+	case string:
+		return val
+	case bool:
+		return strconv.FormatBool(val)
+	case int:
+		return strconv.FormatInt(int64(val), 10)
+	case int8:
+		return strconv.FormatInt(int64(val), 10)
+	case int16:
+		return strconv.FormatInt(int64(val), 10)
+	case int32:
+		return strconv.FormatInt(int64(val), 10)
+	case int64:
+		return strconv.FormatInt(val, 10)
+	case uint:
+		return strconv.FormatUint(uint64(val), 10)
+	case uint8:
+		return strconv.FormatUint(uint64(val), 10)
+	case uint16:
+		return strconv.FormatUint(uint64(val), 10)
+	case uint32:
+		return strconv.FormatUint(uint64(val), 10)
+	case uint64:
+		return strconv.FormatUint(val, 10)
+	case float32:
+		return strconv.FormatFloat(float64(val), 'g', -1, 32)
+	case float64:
+		return strconv.FormatFloat(val, 'g', -1, 64)
+	case time.Time:
+		return val.Format(time.RFC3339Nano)
+	case time.Duration:
+		return val.String()
+	case encoding.TextMarshaler:
+		if b, err := val.MarshalText(); err == nil {
+			return string(b)
+		}
+	case fmt.Stringer:
+		return val.String()
+	}
+
+	// Custom types based on the basic types.
+	rv := reflect.ValueOf(v)
+	switch rv.Kind() {
+	case reflect.String:
+		return rv.String()
+	case reflect.Bool:
+		return strconv.FormatBool(rv.Bool())
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32,
+		reflect.Int64:
+		return strconv.FormatInt(rv.Int(), 10)
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32,
+		reflect.Uint64, reflect.Uintptr:
+		return strconv.FormatUint(rv.Uint(), 10)
+	case reflect.Float32, reflect.Float64:
+		return strconv.FormatFloat(rv.Float(), 'g', -1, rv.Type().Bits())
+	}
+
+	return fmt.Sprint(v)
+}
+
+// FromString converts a string to a value of type T.
+//
+// It is the inverse operation of the ToString function. Strings,
+// booleans and numbers (including custom types based on them) are
+// parsed as the StringTo function does. The time.Time values are
+// parsed with the StringToDate function and the time.Duration values
+// with the time.ParseDuration function.
+//
+// For other types, the function uses the encoding.TextUnmarshaler
+// interface if *T implements it. If the type is not supported,
+// the function returns an error.
+//
+// Example usage:
+//
+//	i, err := g.FromString[int]("42")            // 42, nil
+//	d, err := g.FromString[time.Duration]("1m30s") // 90s, nil
+//	ip, err := g.FromString[netip.Addr]("8.8.8.8") // 8.8.8.8, nil
+//	ch, err := g.FromString[chan int]("1")       // nil, error
+func FromString[T any](s string) (T, error) {
+	var result T
+
+	switch p := any(&result).(type) {
+	case *time.Time:
+		t, err := StringToDate(s)
+		if err != nil {
+			return result, err
+		}
+		*p = t
+		return result, nil
+	case *time.Duration:
+		d, err := time.ParseDuration(s)
+		if err != nil {
+			return result, err
+		}
+		*p = d
+		return result, nil
+	case encoding.TextUnmarshaler:
+		if err := p.UnmarshalText([]byte(s)); err != nil {
+			return *new(T), err
+		}
+		return result, nil
+	}
+
+	rv := reflect.ValueOf(&result).Elem()
+	if rv.Kind() != reflect.String && s == "" {
+		return result, fmt.Errorf("cannot convert empty string to %T", result)
+	}
+
+	if err := setFromString(rv, s); err != nil {
+		return *new(T), err
+	}
+
+	return result, nil
+}
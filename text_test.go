@@ -0,0 +1,134 @@
+package g
+
+import (
+	"fmt"
+	"net/netip"
+	"testing"
+	"time"
+)
+
+// textLevel is a custom type that implements the fmt.Stringer interface.
+type textLevel int
+
+func (l textLevel) String() string {
+	return [...]string{"low", "high"}[l]
+}
+
+// textColor is a custom type with the encoding.TextMarshaler and
+// encoding.TextUnmarshaler interfaces.
+type textColor struct {
+	r, g, b uint8
+}
+
+func (c textColor) MarshalText() ([]byte, error) {
+	return []byte(fmt.Sprintf("#%02x%02x%02x", c.r, c.g, c.b)), nil
+}
+
+func (c *textColor) UnmarshalText(b []byte) error {
+	v, err := StringTo[uint32]("0x" + string(b[1:]))
+	if err != nil {
+		return err
+	}
+
+	c.r, c.g, c.b = uint8(v>>16), uint8(v>>8), uint8(v)
+	return nil
+}
+
+// TestToString tests the ToString function.
+func TestToString(t *testing.T) {
+	type name string
+	type ratio float32
+
+	date := time.Date(2023, 7, 17, 8, 15, 45, 500, time.UTC)
+	tests := []struct {
+		name string
+		got  string
+		want string
+	}{
+		{"String", ToString("go"), "go"},
+		{"Bool", ToString(true), "true"},
+		{"Int", ToString(-42), "-42"},
+		{"Int8", ToString(int8(-8)), "-8"},
+		{"Uint64", ToString(uint64(18446744073709551615)),
+			"18446744073709551615"},
+		{"Float64", ToString(3.14), "3.14"},
+		{"Float32", ToString(float32(0.1)), "0.1"},
+		{"Time", ToString(date), "2023-07-17T08:15:45.0000005Z"},
+		{"Duration", ToString(90 * time.Second), "1m30s"},
+		{"TextMarshaler", ToString(textColor{255, 128, 0}), "#ff8000"},
+		{"Stringer", ToString(textLevel(1)), "high"},
+		{"Custom string", ToString(name("goloop")), "goloop"},
+		{"Custom float", ToString(ratio(0.5)), "0.5"},
+		{"Slice", ToString([]int{1, 2}), "[1 2]"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if tt.got != tt.want {
+				t.Errorf("ToString() = %q, want %q", tt.got, tt.want)
+			}
+		})
+	}
+}
+
+// TestFromString tests the FromString function.
+func TestFromString(t *testing.T) {
+	if v, err := FromString[int]("0x2A"); err != nil || v != 42 {
+		t.Errorf("FromString[int]() = %v, %v", v, err)
+	}
+
+	if v, err := FromString[string](""); err != nil || v != "" {
+		t.Errorf("FromString[string]() = %v, %v", v, err)
+	}
+
+	if v, err := FromString[bool]("yes"); err != nil || !v {
+		t.Errorf("FromString[bool]() = %v, %v", v, err)
+	}
+
+	if _, err := FromString[int](""); err == nil {
+		t.Errorf("FromString[int](\"\") expected error")
+	}
+
+	if _, err := FromString[uint8]("256"); err == nil {
+		t.Errorf("FromString[uint8](\"256\") expected error")
+	}
+
+	if v, err := FromString[time.Duration]("1m30s"); err != nil ||
+		v != 90*time.Second {
+		t.Errorf("FromString[time.Duration]() = %v, %v", v, err)
+	}
+
+	if v, err := FromString[textColor]("#ff8000"); err != nil ||
+		v != (textColor{255, 128, 0}) {
+		t.Errorf("FromString[textColor]() = %v, %v", v, err)
+	}
+
+	if v, err := FromString[netip.Addr]("8.8.8.8"); err != nil ||
+		v.String() != "8.8.8.8" {
+		t.Errorf("FromString[netip.Addr]() = %v, %v", v, err)
+	}
+
+	if _, err := FromString[chan int]("1"); err == nil {
+		t.Errorf("FromString[chan int]() expected error")
+	}
+}
+
+// TestToFromString tests that values survive the conversion
+// to a string and back.
+func TestToFromString(t *testing.T) {
+	date := time.Date(2023, 7, 17, 8, 15, 45, 123456789, time.UTC)
+	if v, err := FromString[time.Time](ToString(date)); err != nil ||
+		!v.Equal(date) {
+		t.Errorf("FromString(ToString(%v)) = %v, %v", date, v, err)
+	}
+
+	f := 0.1 + 0.2
+	if v, err := FromString[float64](ToString(f)); err != nil || v != f {
+		t.Errorf("FromString(ToString(%v)) = %v, %v", f, v, err)
+	}
+
+	i := int64(-9223372036854775808)
+	if v, err := FromString[int64](ToString(i)); err != nil || v != i {
+		t.Errorf("FromString(ToString(%v)) = %v, %v", i, v, err)
+	}
+}
@@ -0,0 +1,286 @@
+package g
+
+import (
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+// TranslitTable is a transliteration table used by the Transliterate
+// and Slugify functions.
+//
+// The keys of the maps are lowercase, the case of the result follows
+// the case of the source: "Щука" becomes "Shchuka" and "ЩУКА" becomes
+// "SHCHUKA".
+type TranslitTable struct {
+	// Chars maps characters to their transliteration.
+	Chars map[rune]string
+
+	// WordStart overrides Chars for the first character of a word,
+	// e.g. the Ukrainian "я" is "ya" at the beginning of a word
+	// and "ia" in other positions.
+	WordStart map[rune]string
+
+	// Sequences maps sequences of characters to their transliteration,
+	// they take precedence over Chars, e.g. the Ukrainian "зг" is "zgh".
+	Sequences map[string]string
+}
+
+var (
+	// TranslitUkrainian is the official transliteration of Ukrainian
+	// approved by the Cabinet of Ministers of Ukraine in 2010
+	// (Resolution No. 55), e.g. "Київ" becomes "Kyiv", "Згорани" becomes
+	// "Zghorany" and "Знам'янка" becomes "Znamianka".
+	TranslitUkrainian = &TranslitTable{
+		Chars: map[rune]string{
+			'а': "a", 'б': "b", 'в': "v", 'г': "h", 'ґ': "g", 'д': "d",
+			'е': "e", 'є': "ie", 'ж': "zh", 'з': "z", 'и': "y", 'і': "i",
+			'ї': "i", 'й': "i", 'к': "k", 'л': "l", 'м': "m", 'н': "n",
+			'о': "o", 'п': "p", 'р': "r", 'с': "s", 'т': "t", 'у': "u",
+			'ф': "f", 'х': "kh", 'ц': "ts", 'ч': "ch", 'ш': "sh",
+			'щ': "shch", 'ь': "", 'ю': "iu", 'я': "ia",
+		},
+		WordStart: map[rune]string{
+			'є': "ye", 'ї': "yi", 'й': "y", 'ю': "yu", 'я': "ya",
+		},
+		Sequences: ukrainianSequences(),
+	}
+
+	// TranslitRussian is the transliteration of Russian according to
+	// ICAO Doc 9303 used in passports, e.g. "Щёкино" becomes "Shchekino".
+	TranslitRussian = &TranslitTable{
+		Chars: map[rune]string{
+			'а': "a", 'б': "b", 'в': "v", 'г': "g", 'д': "d", 'е': "e",
+			'ё': "e", 'ж': "zh", 'з': "z", 'и': "i", 'й': "i", 'к': "k",
+			'л': "l", 'м': "m", 'н': "n", 'о': "o", 'п': "p", 'р': "r",
+			'с': "s", 'т': "t", 'у': "u", 'ф': "f", 'х': "kh", 'ц': "ts",
+			'ч': "ch", 'ш': "sh", 'щ': "shch", 'ъ': "ie", 'ы': "y",
+			'ь': "", 'э': "e", 'ю': "iu", 'я': "ia",
+		},
+	}
+
+	// TranslitGerman is the transliteration of German umlauts and
+	// the sharp s, e.g. "Größe" becomes "Groesse".
+	TranslitGerman = &TranslitTable{
+		Chars: map[rune]string{
+			'ä': "ae", 'ö': "oe", 'ü': "ue", 'ß': "ss",
+		},
+	}
+
+	// TranslitLatin folds Latin letters with diacritics to their base
+	// letters and expands ligatures, e.g. "Crème Brûlée" becomes
+	// "Creme Brulee" and "Łódź" becomes "Lodz".
+	TranslitLatin = &TranslitTable{
+		Chars: latinFolding(),
+	}
+
+	// The defaultTranslitTables are used if no tables are specified,
+	// the Russian table takes the place of the Ukrainian one for Russian
+	// text, see the defaultTranslitTablesFor function.
+	defaultTranslitTables = []*TranslitTable{
+		TranslitUkrainian,
+		TranslitRussian,
+		TranslitGerman,
+		TranslitLatin,
+	}
+)
+
+// The defaultTranslitTablesFor function returns the default tables
+// for the string. The Ukrainian and Russian tables differ for common
+// letters (e.g. "г" is "h" or "g", "и" is "y" or "i"), so the text with
+// letters used only in Russian (ё, ъ, ы, э) and without the letters used
+// only in Ukrainian (ґ, є, і, ї) is transliterated by the Russian table.
+func defaultTranslitTablesFor(s string) []*TranslitTable {
+	russian := false
+	for _, r := range s {
+		switch unicode.ToLower(r) {
+		case 'ґ', 'є', 'і', 'ї':
+			return defaultTranslitTables
+		case 'ё', 'ъ', 'ы', 'э':
+			russian = true
+		}
+	}
+
+	if russian {
+		return defaultTranslitTables[1:]
+	}
+
+	return defaultTranslitTables
+}
+
+// The ukrainianSequences function returns the sequences of the Ukrainian
+// table: "зг" and the apostrophe after consonants, which is omitted.
+func ukrainianSequences() map[string]string {
+	sequences := map[string]string{"зг": "zgh"}
+	consonants := map[rune]string{
+		'б': "b", 'в': "v", 'г': "h", 'ґ': "g", 'д': "d", 'ж': "zh",
+		'з': "z", 'к': "k", 'л': "l", 'м': "m", 'н': "n", 'п': "p",
+		'р': "r", 'с': "s", 'т': "t", 'ф': "f", 'х': "kh", 'ц': "ts",
+		'ч': "ch", 'ш': "sh", 'щ': "shch",
+	}
+
+	for c, latin := range consonants {
+		for _, a := range apostrophes {
+			sequences[string([]rune{c, a})] = latin
+		}
+	}
+
+	return sequences
+}
+
+// The latinFolding function returns the map of Latin letters
+// with diacritics to their base letters.
+func latinFolding() map[rune]string {
+	groups := map[string]string{
+		"a": "àáâãäåāăą", "c": "çćĉċč", "d": "ďđð", "e": "èéêëēĕėęě",
+		"g": "ĝğġģ", "h": "ĥħ", "i": "ìíîïĩīĭįı", "j": "ĵ", "k": "ķ",
+		"l": "ĺļľŀł", "n": "ñńņňŉ", "o": "òóôõöøōŏő", "r": "ŕŗř",
+		"s": "śŝşšș", "t": "ţťŧț", "u": "ùúûüũūŭůűų", "w": "ŵ",
+		"y": "ýÿŷ", "z": "źżž", "ae": "æ", "oe": "œ", "th": "þ",
+		"ss": "ß", "ij": "ĳ",
+	}
+
+	result := make(map[rune]string)
+	for base, chars := range groups {
+		for _, r := range chars {
+			result[r] = base
+		}
+	}
+
+	return result
+}
+
+// The apostrophes are the characters used as an apostrophe:
+// the typewriter apostrophe, the right single quotation mark and
+// the modifier letter apostrophe.
+var apostrophes = []rune{'\'', '’', 'ʼ'}
+
+// The isApostrophe function checks if the rune is an apostrophe.
+func isApostrophe(r rune) bool {
+	return r == '\'' || r == '’' || r == 'ʼ'
+}
+
+// The translitSequence is a sequence of characters
+// of the TranslitTable and its transliteration.
+type translitSequence struct {
+	from []rune
+	to   string
+}
+
+// The hasPrefixFold function checks if the runes start with the prefix,
+// ignoring the case.
+func hasPrefixFold(rs, prefix []rune) bool {
+	if len(rs) < len(prefix) {
+		return false
+	}
+
+	for i, r := range prefix {
+		if unicode.ToLower(rs[i]) != r {
+			return false
+		}
+	}
+
+	return true
+}
+
+// The matchCase function applies the case of the source character
+// to the transliteration: all letters are uppercase if the word is
+// in uppercase, otherwise only the first letter.
+func matchCase(s string, upperWord bool) string {
+	if upperWord {
+		return strings.ToUpper(s)
+	}
+
+	r, size := utf8.DecodeRuneInString(s)
+	if r == utf8.RuneError {
+		return s
+	}
+
+	return string(unicode.ToUpper(r)) + s[size:]
+}
+
+// Transliterate converts characters of the string to Latin
+// by the transliteration tables.
+//
+// If several tables are specified, the first table that contains
+// the character is used. If no tables are specified, the TranslitUkrainian,
+// TranslitRussian, TranslitGerman and TranslitLatin tables are used,
+// except for the text with the letters used only in Russian (ё, ъ, ы, э)
+// and without the letters used only in Ukrainian (ґ, є, і, ї), which
+// is transliterated without the Ukrainian table. Text that has none of
+// these letters is taken as Ukrainian, specify the TranslitRussian table
+// explicitly for Russian text. Characters that are missing in the tables
+// are kept as is.
+//
+// Example usage:
+//
+//	g.Transliterate("Київ, Згорани")  // Output: "Kyiv, Zghorany"
+//	g.Transliterate("Юрій Їжак")      // Output: "Yurii Yizhak"
+//	g.Transliterate("Быстрый поиск")  // Output: "Bystryi poisk"
+//	g.Transliterate("Größe", g.TranslitGerman)        // Output: "Groesse"
+//	g.Transliterate("Łódź, Crème", g.TranslitLatin)  // Output: "Lodz, Creme"
+func Transliterate(s string, tables ...*TranslitTable) string {
+	if len(tables) == 0 {
+		tables = defaultTranslitTablesFor(s)
+	}
+
+	// Sequences grouped by the first character,
+	// in the order of the tables.
+	sequences := make(map[rune][]translitSequence)
+	for _, t := range tables {
+		for from, to := range t.Sequences {
+			rs := []rune(strings.ToLower(from))
+			if len(rs) != 0 {
+				sequences[rs[0]] = append(
+					sequences[rs[0]],
+					translitSequence{from: rs, to: to},
+				)
+			}
+		}
+	}
+
+	var sb strings.Builder
+	sb.Grow(len(s))
+	rs := []rune(s)
+	for i := 0; i < len(rs); {
+		r := rs[i]
+		lr := unicode.ToLower(r)
+		wordStart := i == 0 ||
+			!unicode.IsLetter(rs[i-1]) && !isApostrophe(rs[i-1])
+
+		out, n, ok := "", 1, false
+		for _, seq := range sequences[lr] {
+			if hasPrefixFold(rs[i:], seq.from) {
+				out, n, ok = seq.to, len(seq.from), true
+				break
+			}
+		}
+
+		for j := 0; j < len(tables) && !ok; j++ {
+			if wordStart {
+				out, ok = tables[j].WordStart[lr]
+			}
+
+			if !ok {
+				out, ok = tables[j].Chars[lr]
+			}
+		}
+
+		if !ok {
+			sb.WriteRune(r)
+			i++
+			continue
+		}
+
+		if unicode.IsUpper(r) {
+			upperWord := i+1 < len(rs) && unicode.IsUpper(rs[i+1]) ||
+				i > 0 && unicode.IsUpper(rs[i-1])
+			out = matchCase(out, upperWord)
+		}
+
+		sb.WriteString(out)
+		i += n
+	}
+
+	return sb.String()
+}
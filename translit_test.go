@@ -0,0 +1,51 @@
+package g
+
+import "testing"
+
+// TestTransliterate tests the Transliterate function.
+func TestTransliterate(t *testing.T) {
+	tests := []struct {
+		name   string
+		s      string
+		tables []*TranslitTable
+		want   string
+	}{
+		{"Kyiv", "Київ", nil, "Kyiv"},
+		{"Zgh", "Згорани, Розгон", nil, "Zghorany, Rozghon"},
+		{"Apostrophe", "Знам'янка, Знам’янка", nil, "Znamianka, Znamianka"},
+		{"Word start", "Юрій Ярошенко, Євген Їжакевич", nil,
+			"Yurii Yaroshenko, Yevhen Yizhakevych"},
+		{"Inside word", "Олексій Кузьмин, Стрий", nil,
+			"Oleksii Kuzmyn, Stryi"},
+		{"Soft sign", "Львів, Ґорґани", nil, "Lviv, Gorgany"},
+		{"Shch", "Щербухи, ЩЕРБУХИ", nil, "Shcherbukhy, SHCHERBUKHY"},
+		{"Upper", "ЗГОРАНИ", nil, "ZGHORANY"},
+		{"Russian", "Щёкино, съезд, Эхо", []*TranslitTable{TranslitRussian},
+			"Shchekino, sieezd, Ekho"},
+		{"Russian by default", "Быстрый поиск, Игорь, ёжик", nil,
+			"Bystryi poisk, Igor, ezhik"},
+		{"Ukrainian with Russian letters", "Ігор, ёжик", nil,
+			"Ihor, ezhyk"},
+		{"German", "Über Größe, ÄRGER", []*TranslitTable{TranslitGerman},
+			"Ueber Groesse, AERGER"},
+		{"Latin", "Crème Brûlée, Łódź, Ærø", []*TranslitTable{TranslitLatin},
+			"Creme Brulee, Lodz, Aero"},
+		{"German before Latin", "Müller",
+			[]*TranslitTable{TranslitGerman, TranslitLatin}, "Mueller"},
+		{"Latin before German", "Müller",
+			[]*TranslitTable{TranslitLatin, TranslitGerman}, "Muller"},
+		{"Unknown kept", "Go 漢字 don't", nil, "Go 漢字 don't"},
+		{"Custom", "x-y", []*TranslitTable{{
+			Chars:     map[rune]string{'x': "ks"},
+			Sequences: map[string]string{"-Y": "_"},
+		}}, "ks_"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := Transliterate(tt.s, tt.tables...); got != tt.want {
+				t.Errorf("Transliterate() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,844 @@
+package g
+
+import (
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"net/netip"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// ValidationError describes why a string failed the validation
+// by one of the Validate functions, e.g. ValidateEmail.
+type ValidationError struct {
+	Format string // name of the format, e.g. "email"
+	Value  string // value that failed the validation
+	Reason string // reason of the failure
+}
+
+// Error returns the description of the validation error.
+func (e *ValidationError) Error() string {
+	return fmt.Sprintf("invalid %s %q: %s", e.Format, e.Value, e.Reason)
+}
+
+// The ibanLengths are the lengths of IBANs by country code
+// according to the SWIFT IBAN registry.
+var ibanLengths = map[string]int{
+	"AD": 24, "AE": 23, "AL": 28, "AT": 20, "AZ": 28, "BA": 20, "BE": 16,
+	"BG": 22, "BH": 22, "BR": 29, "BY": 28, "CH": 21, "CR": 22, "CY": 28,
+	"CZ": 24, "DE": 22, "DK": 18, "DO": 28, "EE": 20, "EG": 29, "ES": 24,
+	"FI": 18, "FO": 18, "FR": 27, "GB": 22, "GE": 22, "GI": 23, "GL": 18,
+	"GR": 27, "GT": 28, "HR": 21, "HU": 28, "IE": 22, "IL": 23, "IQ": 23,
+	"IS": 26, "IT": 27, "JO": 30, "KW": 30, "KZ": 20, "LB": 28, "LC": 32,
+	"LI": 21, "LT": 20, "LU": 20, "LV": 21, "MC": 27, "MD": 24, "ME": 22,
+	"MK": 19, "MR": 27, "MT": 31, "MU": 30, "NL": 18, "NO": 15, "PK": 24,
+	"PL": 28, "PS": 29, "PT": 25, "QA": 29, "RO": 24, "RS": 22, "SA": 24,
+	"SC": 31, "SE": 24, "SI": 19, "SK": 24, "SM": 27, "ST": 25, "SV": 28,
+	"TL": 23, "TN": 24, "TR": 26, "UA": 29, "VA": 22, "VG": 24, "XK": 20,
+}
+
+// The iso8601Layouts are the layouts of ISO 8601 calendar dates and
+// date-times in the extended and basic formats. Fractional seconds
+// are accepted by the time.Parse function after the seconds field.
+var iso8601Layouts = func() []string {
+	layouts := []string{"2006-01-02", "2006-01", "20060102"}
+	for _, date := range []string{"2006-01-02T", "20060102T"} {
+		extended := date == "2006-01-02T"
+		times := If(extended,
+			[]string{"15:04:05", "15:04"},
+			[]string{"150405", "1504"},
+		)
+
+		for _, t := range times {
+			layouts = append(layouts, date+t)
+			for _, zone := range []string{"Z07:00", "Z0700", "Z07"} {
+				layouts = append(layouts, date+t+zone)
+			}
+		}
+	}
+
+	return layouts
+}()
+
+// The isDigit function checks if the byte is an ASCII digit.
+func isDigit(c byte) bool {
+	return c >= '0' && c <= '9'
+}
+
+// The isAlnum function checks if the byte is an ASCII letter or digit.
+func isAlnum(c byte) bool {
+	return isDigit(c) || c >= 'a' && c <= 'z' || c >= 'A' && c <= 'Z'
+}
+
+// The isHexDigit function checks if the byte is a hexadecimal digit.
+func isHexDigit(c byte) bool {
+	return isDigit(c) || c >= 'a' && c <= 'f' || c >= 'A' && c <= 'F'
+}
+
+// The hostnameProblem function checks the host name by RFC 1123 and
+// returns the description of the problem or an empty string. If the
+// tld flag is set, the host name must have a top-level domain.
+func hostnameProblem(host string, tld bool) string {
+	if host == "" {
+		return "empty domain"
+	} else if len(host) > 253 {
+		return "domain is longer than 253 characters"
+	}
+
+	labels := strings.Split(host, ".")
+	if tld && len(labels) < 2 {
+		return "missing top-level domain"
+	}
+
+	for _, label := range labels {
+		switch {
+		case label == "":
+			return "empty domain label"
+		case len(label) > 63:
+			return fmt.Sprintf("domain label %q is longer than 63 characters",
+				label)
+		case label[0] == '-' || label[len(label)-1] == '-':
+			return fmt.Sprintf("domain label %q starts or ends with a hyphen",
+				label)
+		}
+
+		for i := 0; i < len(label); i++ {
+			if !isAlnum(label[i]) && label[i] != '-' {
+				return fmt.Sprintf("invalid character %q in domain",
+					label[i])
+			}
+		}
+	}
+
+	if last := labels[len(labels)-1]; len(labels) > 1 &&
+		leadingDigits(last) == len(last) {
+		return "numeric top-level domain"
+	}
+
+	return ""
+}
+
+// The netipReason function extracts the reason from the error
+// of the net/netip package, e.g. `ParseAddr("1.2.3"): IPv4 address
+// too short` becomes "IPv4 address too short".
+func netipReason(err error) string {
+	msg := err.Error()
+	if i := strings.LastIndex(msg, "): "); i >= 0 {
+		return msg[i+3:]
+	}
+
+	return msg
+}
+
+// ValidateEmail checks if the string is an email address in the form
+// local@domain, where the local part consists of ASCII letters, digits,
+// dots (not at the ends or in a row) and the characters !#$%&'*+/=?^_`{|}~-
+// and the domain is a host name with a top-level domain.
+//
+// Example usage:
+//
+//	err := g.ValidateEmail("john.doe@example.com")  // nil
+//	err = g.ValidateEmail("john..doe@example.com")
+//	// Output: invalid email "john..doe@example.com": misplaced dot
+//	// in local part
+func ValidateEmail(s string) error {
+	fail := func(reason string) error {
+		return &ValidationError{Format: "email", Value: s, Reason: reason}
+	}
+
+	at := strings.LastIndexByte(s, '@')
+	switch {
+	case s == "":
+		return fail("empty string")
+	case len(s) > 254:
+		return fail("address is longer than 254 characters")
+	case at < 0:
+		return fail("missing @")
+	}
+
+	local, domain := s[:at], s[at+1:]
+	switch {
+	case local == "":
+		return fail("empty local part")
+	case len(local) > 64:
+		return fail("local part is longer than 64 characters")
+	case local[0] == '.' || local[len(local)-1] == '.' ||
+		strings.Contains(local, ".."):
+		return fail("misplaced dot in local part")
+	}
+
+	for i := 0; i < len(local); i++ {
+		c := local[i]
+		if !isAlnum(c) && strings.IndexByte(".!#$%&'*+/=?^_`{|}~-", c) < 0 {
+			return fail(fmt.Sprintf("invalid character %q in local part",
+				c))
+		}
+	}
+
+	if reason := hostnameProblem(domain, true); reason != "" {
+		return fail(reason)
+	}
+
+	return nil
+}
+
+// IsEmail checks if the string is an email address,
+// see ValidateEmail for details.
+//
+// Example usage:
+//
+//	g.IsEmail("john.doe@example.com")  // Output: true
+//	g.IsEmail("john.doe@localhost")    // Output: false
+func IsEmail(s string) bool {
+	return ValidateEmail(s) == nil
+}
+
+// ValidateURL checks if the string is an absolute URL with a scheme and
+// a host, e.g. "https://example.com/path?q=1". The host must be an IP
+// address or a valid host name, the port must be in the range 1-65535.
+//
+// If the schemes are specified, the URL scheme must be one of them
+// (case-insensitive).
+//
+// Example usage:
+//
+//	err := g.ValidateURL("https://example.com")       // nil
+//	err = g.ValidateURL("ftp://example.com", "https")
+//	// Output: invalid URL "ftp://example.com": scheme "ftp"
+//	// is not allowed
+func ValidateURL(s string, schemes ...string) error {
+	fail := func(reason string) error {
+		return &ValidationError{Format: "URL", Value: s, Reason: reason}
+	}
+
+	if s == "" {
+		return fail("empty string")
+	}
+
+	u, err := url.Parse(s)
+	if err != nil {
+		var urlErr *url.Error
+		if errors.As(err, &urlErr) {
+			err = urlErr.Err
+		}
+
+		return fail(err.Error())
+	}
+
+	if u.Scheme == "" {
+		return fail("missing scheme")
+	}
+
+	if len(schemes) > 0 {
+		allowed := false
+		for _, scheme := range schemes {
+			allowed = allowed || strings.EqualFold(scheme, u.Scheme)
+		}
+
+		if !allowed {
+			return fail(fmt.Sprintf("scheme %q is not allowed", u.Scheme))
+		}
+	}
+
+	if u.Host == "" {
+		return fail("missing host")
+	}
+
+	host := u.Hostname()
+	if _, err := netip.ParseAddr(host); err != nil {
+		if reason := hostnameProblem(host, false); reason != "" {
+			return fail(reason)
+		}
+	}
+
+	if port := u.Port(); port != "" {
+		if n, err := strconv.Atoi(port); err != nil || n < 1 || n > 65535 {
+			return fail(fmt.Sprintf("port %s is out of range", port))
+		}
+	}
+
+	return nil
+}
+
+// IsURL checks if the string is an absolute URL with a scheme and
+// a host, optionally with one of the schemes; see ValidateURL.
+//
+// Example usage:
+//
+//	g.IsURL("https://example.com/path")          // Output: true
+//	g.IsURL("example.com")                       // Output: false
+//	g.IsURL("ws://example.com", "http", "https") // Output: false
+func IsURL(s string, schemes ...string) bool {
+	return ValidateURL(s, schemes...) == nil
+}
+
+// ValidateUUID checks if the string is a UUID in the canonical form
+// xxxxxxxx-xxxx-xxxx-xxxx-xxxxxxxxxxxx of hexadecimal digits
+// in any case. The version and the variant are not checked.
+//
+// Example usage:
+//
+//	err := g.ValidateUUID("123e4567-e89b-12d3-a456-426614174000")  // nil
+//	err = g.ValidateUUID("123e4567e89b12d3a456426614174000")
+//	// Output: invalid UUID "...": must be 36 characters long
+func ValidateUUID(s string) error {
+	fail := func(reason string) error {
+		return &ValidationError{Format: "UUID", Value: s, Reason: reason}
+	}
+
+	if len(s) != 36 {
+		return fail("must be 36 characters long")
+	}
+
+	for i := 0; i < len(s); i++ {
+		switch c := s[i]; {
+		case i == 8 || i == 13 || i == 18 || i == 23:
+			if c != '-' {
+				return fail(fmt.Sprintf("missing hyphen at position %d", i))
+			}
+		case !isHexDigit(c):
+			return fail(fmt.Sprintf("invalid character %q at position %d",
+				c, i))
+		}
+	}
+
+	return nil
+}
+
+// IsUUID checks if the string is a UUID in the canonical form,
+// see ValidateUUID.
+//
+// Example usage:
+//
+//	g.IsUUID("123e4567-e89b-12d3-a456-426614174000")  // Output: true
+//	g.IsUUID("123e4567-e89b-12d3-a456")               // Output: false
+func IsUUID(s string) bool {
+	return ValidateUUID(s) == nil
+}
+
+// ValidateIPv4 checks if the string is an IPv4 address in the dotted
+// decimal form, e.g. "192.168.0.1". Leading zeros are not allowed.
+//
+// Example usage:
+//
+//	err := g.ValidateIPv4("192.168.0.1")  // nil
+//	err = g.ValidateIPv4("192.168.0.256")
+//	// Output: invalid IPv4 address "192.168.0.256": IPv4 field
+//	// has value >255
+func ValidateIPv4(s string) error {
+	fail := func(reason string) error {
+		return &ValidationError{Format: "IPv4 address", Value: s,
+			Reason: reason}
+	}
+
+	addr, err := netip.ParseAddr(s)
+	switch {
+	case err != nil:
+		return fail(netipReason(err))
+	case !addr.Is4():
+		return fail("IPv6 address")
+	}
+
+	return nil
+}
+
+// IsIPv4 checks if the string is an IPv4 address, see ValidateIPv4.
+//
+// Example usage:
+//
+//	g.IsIPv4("192.168.0.1")  // Output: true
+//	g.IsIPv4("::1")          // Output: false
+func IsIPv4(s string) bool {
+	return ValidateIPv4(s) == nil
+}
+
+// ValidateIPv6 checks if the string is an IPv6 address, e.g. "::1" or
+// "2001:db8::8a2e:370:7334", including IPv4-mapped addresses such as
+// "::ffff:192.168.0.1" and addresses with a zone, e.g. "fe80::1%eth0".
+//
+// Example usage:
+//
+//	err := g.ValidateIPv6("2001:db8::1")  // nil
+//	err = g.ValidateIPv6("192.168.0.1")
+//	// Output: invalid IPv6 address "192.168.0.1": IPv4 address
+func ValidateIPv6(s string) error {
+	fail := func(reason string) error {
+		return &ValidationError{Format: "IPv6 address", Value: s,
+			Reason: reason}
+	}
+
+	addr, err := netip.ParseAddr(s)
+	switch {
+	case err != nil:
+		return fail(netipReason(err))
+	case !addr.Is6():
+		return fail("IPv4 address")
+	}
+
+	return nil
+}
+
+// IsIPv6 checks if the string is an IPv6 address, see ValidateIPv6.
+//
+// Example usage:
+//
+//	g.IsIPv6("2001:db8::1")  // Output: true
+//	g.IsIPv6("192.168.0.1")  // Output: false
+func IsIPv6(s string) bool {
+	return ValidateIPv6(s) == nil
+}
+
+// ValidateCIDR checks if the string is an IPv4 or IPv6 address with
+// a prefix length in the CIDR notation, e.g. "192.168.0.0/24" or
+// "2001:db8::/32". Host bits may be set, e.g. "192.168.0.1/24".
+//
+// Example usage:
+//
+//	err := g.ValidateCIDR("10.0.0.0/8")  // nil
+//	err = g.ValidateCIDR("10.0.0.0/33")
+//	// Output: invalid CIDR "10.0.0.0/33": prefix length out of range
+func ValidateCIDR(s string) error {
+	if _, err := netip.ParsePrefix(s); err != nil {
+		return &ValidationError{Format: "CIDR", Value: s,
+			Reason: netipReason(err)}
+	}
+
+	return nil
+}
+
+// IsCIDR checks if the string is an IP address with a prefix length
+// in the CIDR notation, see ValidateCIDR.
+//
+// Example usage:
+//
+//	g.IsCIDR("192.168.0.0/24")  // Output: true
+//	g.IsCIDR("192.168.0.0")     // Output: false
+func IsCIDR(s string) bool {
+	return ValidateCIDR(s) == nil
+}
+
+// ValidateE164Phone checks if the string is a phone number in the E.164
+// format: "+" followed by 2 to 15 digits without spaces, the first
+// digit (of the country code) can't be zero.
+//
+// Example usage:
+//
+//	err := g.ValidateE164Phone("+380671234567")  // nil
+//	err = g.ValidateE164Phone("+380 67 123 45 67")
+//	// Output: invalid E.164 phone number "+380 67 123 45 67":
+//	// invalid character ' '
+func ValidateE164Phone(s string) error {
+	fail := func(reason string) error {
+		return &ValidationError{Format: "E.164 phone number", Value: s,
+			Reason: reason}
+	}
+
+	if !strings.HasPrefix(s, "+") {
+		return fail("must start with +")
+	}
+
+	digits := s[1:]
+	for i := 0; i < len(digits); i++ {
+		if !isDigit(digits[i]) {
+			return fail(fmt.Sprintf("invalid character %q", digits[i]))
+		}
+	}
+
+	switch {
+	case len(digits) < 2:
+		return fail("too short")
+	case len(digits) > 15:
+		return fail("more than 15 digits")
+	case digits[0] == '0':
+		return fail("country code can't start with 0")
+	}
+
+	return nil
+}
+
+// IsE164Phone checks if the string is a phone number in the E.164
+// format, see ValidateE164Phone.
+//
+// Example usage:
+//
+//	g.IsE164Phone("+14155552671")  // Output: true
+//	g.IsE164Phone("4155552671")    // Output: false
+func IsE164Phone(s string) bool {
+	return ValidateE164Phone(s) == nil
+}
+
+// ValidateIBAN checks if the string is an International Bank Account
+// Number: a country code, two check digits and up to 30 uppercase
+// letters and digits. Spaces between groups are allowed, e.g.
+// "GB82 WEST 1234 5698 7654 32".
+//
+// The length is checked for the countries of the SWIFT IBAN registry
+// (15-34 characters for others) and the check digits are verified
+// by the mod-97 algorithm (ISO 7064).
+//
+// Example usage:
+//
+//	err := g.ValidateIBAN("GB82WEST12345698765432")  // nil
+//	err = g.ValidateIBAN("GB83WEST12345698765432")
+//	// Output: invalid IBAN "GB83WEST12345698765432": checksum mismatch
+func ValidateIBAN(s string) error {
+	fail := func(reason string) error {
+		return &ValidationError{Format: "IBAN", Value: s, Reason: reason}
+	}
+
+	iban := strings.ReplaceAll(s, " ", "")
+	if iban == "" {
+		return fail("empty string")
+	} else if len(iban) < 4 {
+		return fail("too short")
+	}
+
+	for i := 0; i < len(iban); i++ {
+		c := iban[i]
+		if !isDigit(c) && (c < 'A' || c > 'Z') {
+			return fail(fmt.Sprintf("invalid character %q", c))
+		}
+	}
+
+	country := iban[:2]
+	switch {
+	case isDigit(country[0]) || isDigit(country[1]):
+		return fail(fmt.Sprintf("invalid country code %q", country))
+	case !isDigit(iban[2]) || !isDigit(iban[3]):
+		return fail(fmt.Sprintf("invalid check digits %q", iban[2:4]))
+	}
+
+	if n, ok := ibanLengths[country]; ok && len(iban) != n {
+		return fail(fmt.Sprintf("%s IBAN must be %d characters long",
+			country, n))
+	} else if len(iban) < 15 || len(iban) > 34 {
+		return fail("must be 15 to 34 characters long")
+	}
+
+	// The country code and the check digits are moved to the end,
+	// letters are replaced by numbers (A = 10, ..., Z = 35).
+	remainder := 0
+	for _, c := range iban[4:] + iban[:4] {
+		if isDigit(byte(c)) {
+			remainder = (remainder*10 + int(c-'0')) % 97
+		} else {
+			remainder = (remainder*100 + int(c-'A') + 10) % 97
+		}
+	}
+
+	if remainder != 1 {
+		return fail("checksum mismatch")
+	}
+
+	return nil
+}
+
+// IsIBAN checks if the string is an International Bank Account Number
+// with valid check digits, see ValidateIBAN.
+//
+// Example usage:
+//
+//	g.IsIBAN("UA213223130000026007233566001")  // Output: true
+//	g.IsIBAN("UA213223130000026007233566002")  // Output: false
+func IsIBAN(s string) bool {
+	return ValidateIBAN(s) == nil
+}
+
+// ValidateLuhn checks if the string of digits passes the Luhn check,
+// as payment card numbers and IMEI do.
+//
+// Example usage:
+//
+//	err := g.ValidateLuhn("4111111111111111")  // nil
+//	err = g.ValidateLuhn("4111111111111112")
+//	// Output: invalid Luhn number "4111111111111112": checksum mismatch
+func ValidateLuhn(s string) error {
+	fail := func(reason string) error {
+		return &ValidationError{Format: "Luhn number", Value: s,
+			Reason: reason}
+	}
+
+	if s == "" {
+		return fail("empty string")
+	}
+
+	sum := 0
+	for i := 0; i < len(s); i++ {
+		c := s[len(s)-1-i]
+		if !isDigit(c) {
+			return fail(fmt.Sprintf("invalid character %q", c))
+		}
+
+		d := int(c - '0')
+		if i%2 == 1 {
+			d *= 2
+			if d > 9 {
+				d -= 9
+			}
+		}
+		sum += d
+	}
+
+	if sum%10 != 0 {
+		return fail("checksum mismatch")
+	}
+
+	return nil
+}
+
+// IsLuhn checks if the string of digits passes the Luhn check,
+// see ValidateLuhn.
+//
+// Example usage:
+//
+//	g.IsLuhn("79927398713")  // Output: true
+//	g.IsLuhn("79927398710")  // Output: false
+func IsLuhn(s string) bool {
+	return ValidateLuhn(s) == nil
+}
+
+// ValidateHex checks if the string consists of hexadecimal digits
+// in any case, optionally with the "0x" or "0X" prefix.
+//
+// Example usage:
+//
+//	err := g.ValidateHex("0xDeadBeef")  // nil
+//	err = g.ValidateHex("0xfg")
+//	// Output: invalid hexadecimal string "0xfg": invalid character 'g'
+func ValidateHex(s string) error {
+	fail := func(reason string) error {
+		return &ValidationError{Format: "hexadecimal string", Value: s,
+			Reason: reason}
+	}
+
+	digits := s
+	if strings.HasPrefix(s, "0x") || strings.HasPrefix(s, "0X") {
+		digits = s[2:]
+	}
+
+	if digits == "" {
+		return fail("no digits")
+	}
+
+	for i := 0; i < len(digits); i++ {
+		if !isHexDigit(digits[i]) {
+			return fail(fmt.Sprintf("invalid character %q", digits[i]))
+		}
+	}
+
+	return nil
+}
+
+// IsHex checks if the string consists of hexadecimal digits,
+// see ValidateHex.
+//
+// Example usage:
+//
+//	g.IsHex("ff00aa")  // Output: true
+//	g.IsHex("0x")      // Output: false
+func IsHex(s string) bool {
+	return ValidateHex(s) == nil
+}
+
+// ValidateBase64 checks if the string is Base64-encoded data in the
+// standard or URL-safe alphabet (RFC 4648), with or without padding.
+//
+// Example usage:
+//
+//	err := g.ValidateBase64("aGVsbG8=")  // nil
+//	err = g.ValidateBase64("aGVsbG8")    // nil, no padding
+//	err = g.ValidateBase64("aGVs*G8=")
+//	// Output: invalid Base64 string "aGVs*G8=": illegal data
+//	// at position 4
+func ValidateBase64(s string) error {
+	if s == "" {
+		return &ValidationError{Format: "Base64 string", Value: s,
+			Reason: "empty string"}
+	}
+
+	urlSafe := strings.ContainsAny(s, "-_")
+	encodings := If(urlSafe,
+		[]*base64.Encoding{base64.URLEncoding, base64.RawURLEncoding},
+		[]*base64.Encoding{base64.StdEncoding, base64.RawStdEncoding},
+	)
+
+	// Strings without padding can't be split into quartets.
+	enc := encodings[If(len(s)%4 == 0, 0, 1)]
+	_, err := enc.DecodeString(s)
+	if err != nil {
+		reason := err.Error()
+		var corrupt base64.CorruptInputError
+		if errors.As(err, &corrupt) {
+			reason = fmt.Sprintf("illegal data at position %d", corrupt)
+		}
+
+		return &ValidationError{Format: "Base64 string", Value: s,
+			Reason: reason}
+	}
+
+	return nil
+}
+
+// IsBase64 checks if the string is Base64-encoded data,
+// see ValidateBase64.
+//
+// Example usage:
+//
+//	g.IsBase64("aGVsbG8gd29ybGQ=")  // Output: true
+//	g.IsBase64("hello world")       // Output: false
+func IsBase64(s string) bool {
+	return ValidateBase64(s) == nil
+}
+
+// The semverIdentifiers function checks the dot-separated identifiers
+// of the pre-release or build metadata of a semantic version and
+// returns the description of the problem or an empty string.
+func semverIdentifiers(s, part string, numeric bool) string {
+	for _, id := range strings.Split(s, ".") {
+		if id == "" {
+			return "empty " + part + " identifier"
+		}
+
+		for i := 0; i < len(id); i++ {
+			if !isAlnum(id[i]) && id[i] != '-' {
+				return fmt.Sprintf("invalid character %q in %s", id[i], part)
+			}
+		}
+
+		if numeric && len(id) > 1 && id[0] == '0' &&
+			leadingDigits(id) == len(id) {
+			return fmt.Sprintf("leading zero in %s identifier %q", part, id)
+		}
+	}
+
+	return ""
+}
+
+// ValidateSemver checks if the string is a semantic version according
+// to Semantic Versioning 2.0.0: MAJOR.MINOR.PATCH with an optional
+// pre-release ("-alpha.1") and build metadata ("+build.5"). Numbers
+// can't have leading zeros. The "v" prefix used by Go modules
+// is allowed.
+//
+// Example usage:
+//
+//	err := g.ValidateSemver("1.2.3-rc.1+build.5")  // nil
+//	err = g.ValidateSemver("1.02.3")
+//	// Output: invalid semantic version "1.02.3": leading zero
+//	// in minor version
+func ValidateSemver(s string) error {
+	fail := func(reason string) error {
+		return &ValidationError{Format: "semantic version", Value: s,
+			Reason: reason}
+	}
+
+	v := strings.TrimPrefix(s, "v")
+	if v == "" {
+		return fail("empty version")
+	}
+
+	if i := strings.IndexByte(v, '+'); i >= 0 {
+		if reason := semverIdentifiers(v[i+1:], "build metadata",
+			false); reason != "" {
+			return fail(reason)
+		}
+		v = v[:i]
+	}
+
+	if i := strings.IndexByte(v, '-'); i >= 0 {
+		if reason := semverIdentifiers(v[i+1:], "pre-release",
+			true); reason != "" {
+			return fail(reason)
+		}
+		v = v[:i]
+	}
+
+	parts := strings.Split(v, ".")
+	if len(parts) != 3 {
+		return fail("must be in the form MAJOR.MINOR.PATCH")
+	}
+
+	for i, part := range parts {
+		name := []string{"major", "minor", "patch"}[i]
+		switch {
+		case part == "" || leadingDigits(part) != len(part):
+			return fail(fmt.Sprintf("%s version must be a number", name))
+		case len(part) > 1 && part[0] == '0':
+			return fail(fmt.Sprintf("leading zero in %s version", name))
+		}
+	}
+
+	return nil
+}
+
+// IsSemver checks if the string is a semantic version,
+// see ValidateSemver.
+//
+// Example usage:
+//
+//	g.IsSemver("v1.20.0")        // Output: true
+//	g.IsSemver("1.2.3-beta..1")  // Output: false
+func IsSemver(s string) bool {
+	return ValidateSemver(s) == nil
+}
+
+// ValidateISO8601 checks if the string is an ISO 8601 calendar date
+// or date-time in the extended ("2024-03-08T14:05:00Z") or basic
+// ("20240308T140500Z") format. The time can have fractional seconds and
+// a time zone offset ("Z", "+02:00", "+0200" or "+02"). The values
+// are checked, e.g. "2023-02-29" is invalid.
+//
+// Example usage:
+//
+//	err := g.ValidateISO8601("2024-03-08T14:05:00.123+02:00")  // nil
+//	err = g.ValidateISO8601("2024-03-08 14:05")
+//	// Output: invalid ISO 8601 date "2024-03-08 14:05": unknown
+//	// date format
+func ValidateISO8601(s string) error {
+	fail := func(reason string) error {
+		return &ValidationError{Format: "ISO 8601 date", Value: s,
+			Reason: reason}
+	}
+
+	// The time.Parse function accepts hours without a leading zero.
+	if i := strings.IndexByte(s, 'T'); i >= 0 &&
+		(len(s) < i+3 || !isDigit(s[i+1]) || !isDigit(s[i+2])) {
+		return fail("hour must have two digits")
+	}
+
+	var rangeErr error
+	for _, layout := range iso8601Layouts {
+		_, err := time.Parse(layout, s)
+		if err == nil {
+			return nil
+		}
+
+		// The layout matches, but a value is out of range.
+		var parseErr *time.ParseError
+		if rangeErr == nil && errors.As(err, &parseErr) &&
+			strings.HasSuffix(parseErr.Message, "out of range") {
+			rangeErr = errors.New(strings.TrimPrefix(parseErr.Message, ": "))
+		}
+	}
+
+	if rangeErr != nil {
+		return fail(rangeErr.Error())
+	}
+
+	return fail("unknown date format")
+}
+
+// IsISO8601 checks if the string is an ISO 8601 calendar date or
+// date-time, see ValidateISO8601.
+//
+// Example usage:
+//
+//	g.IsISO8601("2024-03-08")            // Output: true
+//	g.IsISO8601("2024-03-08T14:05:00Z")  // Output: true
+//	g.IsISO8601("08.03.2024")            // Output: false
+func IsISO8601(s string) bool {
+	return ValidateISO8601(s) == nil
+}
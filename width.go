@@ -0,0 +1,383 @@
+package g
+
+import (
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+// The wideTable contains the East Asian Wide (W) and Fullwidth (F)
+// characters that take two columns in a terminal: CJK ideographs,
+// Hangul syllables, Hiragana, Katakana, fullwidth forms and emoji
+// with the default emoji presentation.
+var wideTable = &unicode.RangeTable{
+	R16: []unicode.Range16{
+		{Lo: 0x1100, Hi: 0x115f, Stride: 1},
+		{Lo: 0x231a, Hi: 0x231b, Stride: 1},
+		{Lo: 0x2329, Hi: 0x232a, Stride: 1},
+		{Lo: 0x23e9, Hi: 0x23ec, Stride: 1},
+		{Lo: 0x23f0, Hi: 0x23f0, Stride: 1},
+		{Lo: 0x23f3, Hi: 0x23f3, Stride: 1},
+		{Lo: 0x25fd, Hi: 0x25fe, Stride: 1},
+		{Lo: 0x2614, Hi: 0x2615, Stride: 1},
+		{Lo: 0x2648, Hi: 0x2653, Stride: 1},
+		{Lo: 0x267f, Hi: 0x267f, Stride: 1},
+		{Lo: 0x2693, Hi: 0x2693, Stride: 1},
+		{Lo: 0x26a1, Hi: 0x26a1, Stride: 1},
+		{Lo: 0x26aa, Hi: 0x26ab, Stride: 1},
+		{Lo: 0x26bd, Hi: 0x26be, Stride: 1},
+		{Lo: 0x26c4, Hi: 0x26c5, Stride: 1},
+		{Lo: 0x26ce, Hi: 0x26ce, Stride: 1},
+		{Lo: 0x26d4, Hi: 0x26d4, Stride: 1},
+		{Lo: 0x26ea, Hi: 0x26ea, Stride: 1},
+		{Lo: 0x26f2, Hi: 0x26f3, Stride: 1},
+		{Lo: 0x26f5, Hi: 0x26f5, Stride: 1},
+		{Lo: 0x26fa, Hi: 0x26fa, Stride: 1},
+		{Lo: 0x26fd, Hi: 0x26fd, Stride: 1},
+		{Lo: 0x2705, Hi: 0x2705, Stride: 1},
+		{Lo: 0x270a, Hi: 0x270b, Stride: 1},
+		{Lo: 0x2728, Hi: 0x2728, Stride: 1},
+		{Lo: 0x274c, Hi: 0x274c, Stride: 1},
+		{Lo: 0x274e, Hi: 0x274e, Stride: 1},
+		{Lo: 0x2753, Hi: 0x2755, Stride: 1},
+		{Lo: 0x2757, Hi: 0x2757, Stride: 1},
+		{Lo: 0x2795, Hi: 0x2797, Stride: 1},
+		{Lo: 0x27b0, Hi: 0x27b0, Stride: 1},
+		{Lo: 0x27bf, Hi: 0x27bf, Stride: 1},
+		{Lo: 0x2b1b, Hi: 0x2b1c, Stride: 1},
+		{Lo: 0x2b50, Hi: 0x2b50, Stride: 1},
+		{Lo: 0x2b55, Hi: 0x2b55, Stride: 1},
+		{Lo: 0x2e80, Hi: 0x303e, Stride: 1},
+		{Lo: 0x3041, Hi: 0x33ff, Stride: 1},
+		{Lo: 0x3400, Hi: 0x4dbf, Stride: 1},
+		{Lo: 0x4e00, Hi: 0x9fff, Stride: 1},
+		{Lo: 0xa000, Hi: 0xa4cf, Stride: 1},
+		{Lo: 0xa960, Hi: 0xa97f, Stride: 1},
+		{Lo: 0xac00, Hi: 0xd7a3, Stride: 1},
+		{Lo: 0xf900, Hi: 0xfaff, Stride: 1},
+		{Lo: 0xfe10, Hi: 0xfe19, Stride: 1},
+		{Lo: 0xfe30, Hi: 0xfe6f, Stride: 1},
+		{Lo: 0xff00, Hi: 0xff60, Stride: 1},
+		{Lo: 0xffe0, Hi: 0xffe6, Stride: 1},
+	},
+	R32: []unicode.Range32{
+		{Lo: 0x16fe0, Hi: 0x16fe4, Stride: 1},
+		{Lo: 0x17000, Hi: 0x18aff, Stride: 1},
+		{Lo: 0x1b000, Hi: 0x1b2ff, Stride: 1},
+		{Lo: 0x1f004, Hi: 0x1f004, Stride: 1},
+		{Lo: 0x1f0cf, Hi: 0x1f0cf, Stride: 1},
+		{Lo: 0x1f18e, Hi: 0x1f18e, Stride: 1},
+		{Lo: 0x1f191, Hi: 0x1f19a, Stride: 1},
+		{Lo: 0x1f200, Hi: 0x1f202, Stride: 1},
+		{Lo: 0x1f210, Hi: 0x1f23b, Stride: 1},
+		{Lo: 0x1f240, Hi: 0x1f248, Stride: 1},
+		{Lo: 0x1f250, Hi: 0x1f251, Stride: 1},
+		{Lo: 0x1f260, Hi: 0x1f265, Stride: 1},
+		{Lo: 0x1f300, Hi: 0x1f320, Stride: 1},
+		{Lo: 0x1f32d, Hi: 0x1f335, Stride: 1},
+		{Lo: 0x1f337, Hi: 0x1f37c, Stride: 1},
+		{Lo: 0x1f37e, Hi: 0x1f393, Stride: 1},
+		{Lo: 0x1f3a0, Hi: 0x1f3ca, Stride: 1},
+		{Lo: 0x1f3cf, Hi: 0x1f3d3, Stride: 1},
+		{Lo: 0x1f3e0, Hi: 0x1f3f0, Stride: 1},
+		{Lo: 0x1f3f4, Hi: 0x1f3f4, Stride: 1},
+		{Lo: 0x1f3f8, Hi: 0x1f43e, Stride: 1},
+		{Lo: 0x1f440, Hi: 0x1f440, Stride: 1},
+		{Lo: 0x1f442, Hi: 0x1f4fc, Stride: 1},
+		{Lo: 0x1f4ff, Hi: 0x1f53d, Stride: 1},
+		{Lo: 0x1f54b, Hi: 0x1f54e, Stride: 1},
+		{Lo: 0x1f550, Hi: 0x1f567, Stride: 1},
+		{Lo: 0x1f57a, Hi: 0x1f57a, Stride: 1},
+		{Lo: 0x1f595, Hi: 0x1f596, Stride: 1},
+		{Lo: 0x1f5a4, Hi: 0x1f5a4, Stride: 1},
+		{Lo: 0x1f5fb, Hi: 0x1f64f, Stride: 1},
+		{Lo: 0x1f680, Hi: 0x1f6c5, Stride: 1},
+		{Lo: 0x1f6cc, Hi: 0x1f6cc, Stride: 1},
+		{Lo: 0x1f6d0, Hi: 0x1f6d2, Stride: 1},
+		{Lo: 0x1f6d5, Hi: 0x1f6d7, Stride: 1},
+		{Lo: 0x1f6eb, Hi: 0x1f6ec, Stride: 1},
+		{Lo: 0x1f6f4, Hi: 0x1f6fc, Stride: 1},
+		{Lo: 0x1f7e0, Hi: 0x1f7eb, Stride: 1},
+		{Lo: 0x1f90c, Hi: 0x1f93a, Stride: 1},
+		{Lo: 0x1f93c, Hi: 0x1f945, Stride: 1},
+		{Lo: 0x1f947, Hi: 0x1f9ff, Stride: 1},
+		{Lo: 0x1fa70, Hi: 0x1faff, Stride: 1},
+		{Lo: 0x20000, Hi: 0x2fffd, Stride: 1},
+		{Lo: 0x30000, Hi: 0x3fffd, Stride: 1},
+	},
+}
+
+// The runeWidth function returns the number of terminal columns
+// taken by the rune: 0 for control characters, combining marks and
+// format characters, 2 for wide characters and 1 for others.
+func runeWidth(r rune) int {
+	switch {
+	case r < 0x20 || r >= 0x7f && r < 0xa0:
+		return 0
+	case r < utf8.RuneSelf:
+		return 1
+	case r >= 0x1160 && r <= 0x11ff: // Hangul medial vowels, final consonants
+		return 0
+	case unicode.In(r, unicode.Mn, unicode.Me, unicode.Cf):
+		return 0
+	case unicode.Is(wideTable, r):
+		return 2
+	}
+
+	return 1
+}
+
+// The isGraphemeExtend function checks if the rune extends the previous
+// grapheme cluster: combining marks, emoji skin tone modifiers, tags
+// and Hangul medial vowels and final consonants.
+func isGraphemeExtend(r rune) bool {
+	return r >= 0x1f3fb && r <= 0x1f3ff ||
+		r >= 0xe0020 && r <= 0xe007f ||
+		r >= 0x1160 && r <= 0x11ff ||
+		r >= 0xd7b0 && r <= 0xd7ff ||
+		unicode.In(r, unicode.Mn, unicode.Me, unicode.Mc)
+}
+
+// The isRegionalIndicator function checks if the rune is a regional
+// indicator symbol, pairs of them are flags.
+func isRegionalIndicator(r rune) bool {
+	return r >= 0x1f1e6 && r <= 0x1f1ff
+}
+
+// The nextGrapheme function returns the size in bytes and the display
+// width of the first grapheme cluster of the string: a character with
+// the following combining marks and modifiers, an emoji sequence joined
+// by the zero width joiner, a flag (a pair of regional indicators)
+// or CRLF.
+func nextGrapheme(s string) (size, width int) {
+	r, size := utf8.DecodeRuneInString(s)
+	if r == '\r' && size < len(s) && s[size] == '\n' {
+		return 2, 0
+	}
+
+	width = runeWidth(r)
+	flag := isRegionalIndicator(r)
+	for size < len(s) {
+		next, n := utf8.DecodeRuneInString(s[size:])
+		switch {
+		case flag && isRegionalIndicator(next):
+			flag, width = false, 2
+		case next == 0x200d: // zero width joiner, joins the next character
+			if size+n < len(s) {
+				_, m := utf8.DecodeRuneInString(s[size+n:])
+				n += m
+			}
+			if Emoji(r) {
+				width = 2
+			}
+		case next == 0xfe0f: // emoji presentation selector
+			if Emoji(r) {
+				width = 2
+			}
+		case next == 0xfe0e: // text presentation selector
+			if Emoji(r) {
+				width = 1
+			}
+		case isGraphemeExtend(next):
+		default:
+			return size, width
+		}
+		size += n
+	}
+
+	return size, width
+}
+
+// DisplayWidth returns the number of columns that the string takes
+// in a monospaced terminal.
+//
+// East Asian wide and fullwidth characters and emoji take two columns,
+// combining marks, control and format characters take none. Emoji
+// sequences (e.g. a family or a flag) are counted as a single emoji.
+//
+// Example usage:
+//
+//	g.DisplayWidth("Hello")        // Output: 5
+//	g.DisplayWidth("\u4f60\u597d")  // Output: 4 ("你好")
+//	g.DisplayWidth("e\u0301")      // Output: 1 ("é" with a combining accent)
+//	g.DisplayWidth("👍🏽🇺🇦")         // Output: 4
+func DisplayWidth(s string) int {
+	width := 0
+	for len(s) > 0 {
+		size, w := nextGrapheme(s)
+		width += w
+		s = s[size:]
+	}
+
+	return width
+}
+
+// Truncate shortens the string to fit into the width in columns (see
+// DisplayWidth) and appends the ellipsis to the truncated string.
+// The string is never cut inside a grapheme cluster, so the result can
+// be narrower than the width, e.g. when a wide character doesn't fit.
+//
+// The default ellipsis is "…", pass an empty string to cut the string
+// without it. The string that fits into the width is returned as is.
+//
+// Example usage:
+//
+//	g.Truncate("Hello, World", 8)         // Output: "Hello, …"
+//	g.Truncate("Hello, World", 8, "...")  // Output: "Hello..."
+//	g.Truncate("你好世界", 5)              // Output: "你好…"
+func Truncate(s string, width int, ellipsis ...string) string {
+	if DisplayWidth(s) <= width {
+		return s
+	}
+
+	tail := "…"
+	if len(ellipsis) > 0 {
+		tail = ellipsis[0]
+	}
+
+	limit := width - DisplayWidth(tail)
+	if limit < 0 {
+		limit, tail = width, ""
+	}
+
+	end, used := 0, 0
+	for end < len(s) {
+		size, w := nextGrapheme(s[end:])
+		if used+w > limit {
+			break
+		}
+		end, used = end+size, used+w
+	}
+
+	return s[:end] + tail
+}
+
+// The padding function returns the padding of the width in columns
+// made of the pad rune; if the rune is wide and doesn't fit, the rest
+// is filled with spaces.
+func padding(width int, pad []rune) string {
+	if width <= 0 {
+		return ""
+	}
+
+	r := ' '
+	if len(pad) > 0 {
+		r = pad[0]
+	}
+
+	w := runeWidth(r)
+	if w == 0 {
+		r, w = ' ', 1
+	}
+
+	return strings.Repeat(string(r), width/w) + strings.Repeat(" ", width%w)
+}
+
+// PadLeft pads the string on the left to the width in columns (see
+// DisplayWidth), i.e. aligns it to the right. The default pad rune is
+// the space. The string that is already wider is returned as is.
+//
+// Example usage:
+//
+//	g.PadLeft("42", 5)       // Output: "   42"
+//	g.PadLeft("42", 5, '0')  // Output: "00042"
+//	g.PadLeft("你好", 6)      // Output: "  你好"
+func PadLeft(s string, width int, pad ...rune) string {
+	return padding(width-DisplayWidth(s), pad) + s
+}
+
+// PadRight pads the string on the right to the width in columns (see
+// DisplayWidth), i.e. aligns it to the left. The default pad rune is
+// the space. The string that is already wider is returned as is.
+//
+// Example usage:
+//
+//	g.PadRight("42", 5)       // Output: "42   "
+//	g.PadRight("你好", 6, '.') // Output: "你好.."
+func PadRight(s string, width int, pad ...rune) string {
+	return s + padding(width-DisplayWidth(s), pad)
+}
+
+// Center pads the string on both sides to the width in columns (see
+// DisplayWidth). If the padding can't be split evenly, the extra
+// column goes to the right. The default pad rune is the space.
+//
+// Example usage:
+//
+//	g.Center("Go", 6)       // Output: "  Go  "
+//	g.Center("Go", 7, '*')  // Output: "**Go***"
+func Center(s string, width int, pad ...rune) string {
+	total := width - DisplayWidth(s)
+	if total <= 0 {
+		return s
+	}
+
+	return padding(total/2, pad) + s + padding(total-total/2, pad)
+}
+
+// WordWrap wraps the string into lines that fit into the width
+// in columns (see DisplayWidth).
+//
+// Lines are broken at spaces, which are collapsed; existing line breaks
+// are kept. A word that is wider than the width is split between lines,
+// but never inside a grapheme cluster. If the width is not positive,
+// the string is returned as is.
+//
+// Example usage:
+//
+//	g.WordWrap("The quick brown fox", 10)
+//	// Output: "The quick\nbrown fox"
+//
+//	g.WordWrap("你好世界", 5)
+//	// Output: "你好\n世界"
+func WordWrap(s string, width int) string {
+	if width <= 0 {
+		return s
+	}
+
+	var sb strings.Builder
+	for i, paragraph := range strings.Split(s, "\n") {
+		if i > 0 {
+			sb.WriteByte('\n')
+		}
+
+		used := 0
+		for _, word := range strings.Fields(paragraph) {
+			ww := DisplayWidth(word)
+			if used > 0 && used+1+ww <= width {
+				sb.WriteByte(' ')
+				sb.WriteString(word)
+				used += 1 + ww
+				continue
+			}
+
+			if used > 0 {
+				sb.WriteByte('\n')
+				used = 0
+			}
+
+			// Split the word that doesn't fit into the line.
+			for ww > width {
+				end, w := 0, 0
+				for end < len(word) {
+					size, gw := nextGrapheme(word[end:])
+					if w+gw > width && end > 0 {
+						break
+					}
+					end, w = end+size, w+gw
+				}
+
+				sb.WriteString(word[:end])
+				word, ww = word[end:], ww-w
+				if len(word) > 0 {
+					sb.WriteByte('\n')
+				}
+			}
+
+			sb.WriteString(word)
+			used = ww
+		}
+	}
+
+	return sb.String()
+}
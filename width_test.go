@@ -0,0 +1,132 @@
+package g
+
+import "testing"
+
+// TestDisplayWidth tests the DisplayWidth function.
+func TestDisplayWidth(t *testing.T) {
+	tests := []struct {
+		name string
+		s    string
+		want int
+	}{
+		{"Empty", "", 0},
+		{"ASCII", "Hello", 5},
+		{"Cyrillic", "Привіт", 6},
+		{"CJK", "你好", 4},
+		{"Fullwidth", "ＡＢ", 4},
+		{"Hangul", "한국어", 6},
+		{"Combining", "e\u0301", 1},
+		{"Combining many", "a\u0300\u0301\u0302", 1},
+		{"Control", "a\tb\x00", 2},
+		{"Zero width space", "a\u200bb", 2},
+		{"Emoji", "🚀", 2},
+		{"Skin tone", "👍\U0001f3fd", 2},
+		{"Flag", "\U0001f1fa\U0001f1e6", 2},
+		{"Two flags", "\U0001f1fa\U0001f1e6\U0001f1f5\U0001f1f1", 4},
+		{"ZWJ family", "👨\u200d👩\u200d👧", 2},
+		{"Emoji presentation", "❤\ufe0f", 2},
+		{"Text presentation", "❤", 1},
+		{"Keycap", "1\ufe0f\u20e3", 1},
+		{"Mixed", "Go 你好 🚀!", 11},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := DisplayWidth(tt.s); got != tt.want {
+				t.Errorf("DisplayWidth(%q) = %d, want %d", tt.s, got, tt.want)
+			}
+		})
+	}
+}
+
+// TestTruncate tests the Truncate function.
+func TestTruncate(t *testing.T) {
+	tests := []struct {
+		name     string
+		s        string
+		width    int
+		ellipsis []string
+		want     string
+	}{
+		{"Fits", "Hello", 5, nil, "Hello"},
+		{"Default ellipsis", "Hello, World", 8, nil, "Hello, …"},
+		{"Custom ellipsis", "Hello, World", 8, []string{"..."}, "Hello..."},
+		{"No ellipsis", "Hello, World", 5, []string{""}, "Hello"},
+		{"Wide", "你好世界", 5, nil, "你好…"},
+		{"Wide even", "你好世界", 6, nil, "你好…"},
+		{"Combining", "e\u0301e\u0301e\u0301", 2, nil, "e\u0301…"},
+		{"Emoji sequence", "👨\u200d👩\u200d👧 family", 3, nil, "👨\u200d👩\u200d👧…"},
+		{"Flag", "\U0001f1fa\U0001f1e6\U0001f1f5\U0001f1f1", 3, nil, "\U0001f1fa\U0001f1e6…"},
+		{"Ellipsis too wide", "Hello", 2, []string{"..."}, "He"},
+		{"Zero width", "Hello", 0, nil, ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := Truncate(tt.s, tt.width, tt.ellipsis...)
+			if got != tt.want {
+				t.Errorf("Truncate() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+// TestPad tests the PadLeft, PadRight and Center functions.
+func TestPad(t *testing.T) {
+	tests := []struct {
+		name string
+		got  string
+		want string
+	}{
+		{"PadLeft", PadLeft("42", 5), "   42"},
+		{"PadLeft zero", PadLeft("42", 5, '0'), "00042"},
+		{"PadLeft wide", PadLeft("你好", 6), "  你好"},
+		{"PadLeft wider", PadLeft("Hello", 3), "Hello"},
+		{"PadLeft wide pad", PadLeft("a", 4, '你'), "你 a"},
+		{"PadRight", PadRight("42", 5), "42   "},
+		{"PadRight dots", PadRight("你好", 6, '.'), "你好.."},
+		{"PadRight combining", PadRight("e\u0301", 3), "e\u0301  "},
+		{"Center", Center("Go", 6), "  Go  "},
+		{"Center odd", Center("Go", 7, '*'), "**Go***"},
+		{"Center wider", Center("Hello", 3), "Hello"},
+		{"Center emoji", Center("🚀", 4), " 🚀 "},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if tt.got != tt.want {
+				t.Errorf("%s = %q, want %q", tt.name, tt.got, tt.want)
+			}
+		})
+	}
+}
+
+// TestWordWrap tests the WordWrap function.
+func TestWordWrap(t *testing.T) {
+	tests := []struct {
+		name  string
+		s     string
+		width int
+		want  string
+	}{
+		{"Simple", "The quick brown fox", 10, "The quick\nbrown fox"},
+		{"Exact", "abc def", 7, "abc def"},
+		{"Collapse spaces", "a   b    c", 3, "a b\nc"},
+		{"Keep breaks", "abc\ndef ghi", 5, "abc\ndef\nghi"},
+		{"Long word", "abcdefghij", 4, "abcd\nefgh\nij"},
+		{"Long word after", "ab cdefgh", 4, "ab\ncdef\ngh"},
+		{"Wide", "你好世界", 5, "你好\n世界"},
+		{"Combining", "e\u0301e\u0301e\u0301", 2, "e\u0301e\u0301\ne\u0301"},
+		{"Emoji", "🚀🚀🚀", 3, "🚀\n🚀\n🚀"},
+		{"Too narrow", "你好", 1, "你\n好"},
+		{"Zero width", "a b", 0, "a b"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := WordWrap(tt.s, tt.width); got != tt.want {
+				t.Errorf("WordWrap() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
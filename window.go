@@ -0,0 +1,259 @@
+package g
+
+import "math"
+
+// The cumulative function returns a slice where each element is the
+// result of applying the function f to the previous result and the
+// current value. The first element of the result is the first value.
+func cumulative[T Numerable](v []T, f func(acc, val T) T) []T {
+	result := make([]T, len(v))
+	if len(v) == 0 {
+		return result
+	}
+
+	result[0] = v[0]
+	for i := 1; i < len(v); i++ {
+		result[i] = f(result[i-1], v[i])
+	}
+
+	return result
+}
+
+// CumSum returns the cumulative sum of the values: each element
+// of the result is the sum of all values up to and including
+// the element with the same index.
+//
+// Note: This function does not handle overflow.
+//
+// Example usage:
+//
+//	g.CumSum([]int{1, 2, 3, 4})  // Output: [1 3 6 10]
+func CumSum[T Numerable](v []T) []T {
+	return cumulative(v, func(acc, val T) T { return acc + val })
+}
+
+// CumProd returns the cumulative product of the values: each element
+// of the result is the product of all values up to and including
+// the element with the same index.
+//
+// Note: This function does not handle overflow.
+//
+// Example usage:
+//
+//	g.CumProd([]int{1, 2, 3, 4})  // Output: [1 2 6 24]
+func CumProd[T Numerable](v []T) []T {
+	return cumulative(v, func(acc, val T) T { return acc * val })
+}
+
+// CumMax returns the cumulative maximum of the values: each element
+// of the result is the largest value up to and including the element
+// with the same index.
+//
+// Example usage:
+//
+//	g.CumMax([]int{1, 3, 2, 5, 4})  // Output: [1 3 3 5 5]
+func CumMax[T Numerable](v []T) []T {
+	return cumulative(v, func(acc, val T) T { return Max(acc, val) })
+}
+
+// CumMin returns the cumulative minimum of the values: each element
+// of the result is the smallest value up to and including the element
+// with the same index.
+//
+// Example usage:
+//
+//	g.CumMin([]int{5, 3, 4, 1, 2})  // Output: [5 3 3 1 1]
+func CumMin[T Numerable](v []T) []T {
+	return cumulative(v, func(acc, val T) T { return Min(acc, val) })
+}
+
+// Deltas returns the differences between consecutive values.
+//
+// The result is aligned with the input: the element with index i
+// is v[i] - v[i-1], and the first element is always zero because
+// there is no previous value.
+//
+// Example usage:
+//
+//	g.Deltas([]int{1, 4, 9, 16})  // Output: [0 3 5 7]
+func Deltas[T Numerable](v []T) []T {
+	result := make([]T, len(v))
+	for i := 1; i < len(v); i++ {
+		result[i] = v[i] - v[i-1]
+	}
+
+	return result
+}
+
+// MovingAverage returns the simple moving average of the values
+// over a fixed window.
+//
+// The result is aligned with the input: the element with index i is
+// the average of the window of values ending at index i. The first
+// window-1 elements, for which the window is incomplete, are NaN.
+//
+// The function returns nil if the window is less than or equal to zero.
+//
+// Example usage:
+//
+//	g.MovingAverage([]int{1, 2, 3, 4, 5}, 3)
+//	// Output: [NaN NaN 2 3 4]
+func MovingAverage[T Numerable](v []T, window int) []float64 {
+	if window <= 0 {
+		return nil
+	}
+
+	// The running sum of the finite values is compensated (Neumaier
+	// summation), so that small values aren't lost next to large ones.
+	// Non-finite values are counted instead, so the average recovers
+	// once they leave the window.
+	var (
+		sum, compensation    float64
+		nans, posInf, negInf int
+	)
+
+	update := func(x float64, delta int) {
+		switch {
+		case math.IsNaN(x):
+			nans += delta
+		case math.IsInf(x, 1):
+			posInf += delta
+		case math.IsInf(x, -1):
+			negInf += delta
+		default:
+			x *= float64(delta)
+			t := sum + x
+			if math.Abs(sum) >= math.Abs(x) {
+				compensation += (sum - t) + x
+			} else {
+				compensation += (x - t) + sum
+			}
+			sum = t
+		}
+	}
+
+	result := make([]float64, len(v))
+	for i, val := range v {
+		update(float64(val), 1)
+		if i >= window {
+			update(float64(v[i-window]), -1)
+		}
+
+		switch {
+		case i < window-1, nans > 0, posInf > 0 && negInf > 0:
+			result[i] = math.NaN()
+		case posInf > 0:
+			result[i] = math.Inf(1)
+		case negInf > 0:
+			result[i] = math.Inf(-1)
+		default:
+			result[i] = (sum + compensation) / float64(window)
+		}
+	}
+
+	return result
+}
+
+// WeightedMovingAverage returns the linearly weighted moving average
+// of the values over a fixed window.
+//
+// Within each window, the oldest value has the weight 1 and the most
+// recent value has the weight equal to the window size. The result is
+// aligned with the input, the first window-1 elements are NaN.
+//
+// The function returns nil if the window is less than or equal to zero.
+//
+// Example usage:
+//
+//	g.WeightedMovingAverage([]int{1, 2, 3, 4}, 2)
+//	// Output: [NaN 1.6666666666666667 2.6666666666666665 3.6666666666666665]
+func WeightedMovingAverage[T Numerable](v []T, window int) []float64 {
+	if window <= 0 {
+		return nil
+	}
+
+	// The weights form an arithmetic series 1..window.
+	denominator := float64(window*(window+1)) / 2
+	result := make([]float64, len(v))
+	for i := range v {
+		if i < window-1 {
+			result[i] = math.NaN()
+			continue
+		}
+
+		sum := 0.0
+		for w := 1; w <= window; w++ {
+			sum += float64(w) * float64(v[i-window+w])
+		}
+		result[i] = sum / denominator
+	}
+
+	return result
+}
+
+// ExponentialMovingAverage returns the exponential moving average
+// of the values over a fixed window.
+//
+// The smoothing factor is 2/(window+1). The average is seeded with
+// the simple average of the first window values, so the element with
+// index window-1 is the first defined one. The result is aligned with
+// the input, the first window-1 elements are NaN.
+//
+// The function returns nil if the window is less than or equal to zero.
+//
+// Example usage:
+//
+//	g.ExponentialMovingAverage([]int{1, 2, 3, 4, 5}, 3)
+//	// Output: [NaN NaN 2 3 4]
+func ExponentialMovingAverage[T Numerable](v []T, window int) []float64 {
+	if window <= 0 {
+		return nil
+	}
+
+	alpha := 2 / float64(window+1)
+	result := make([]float64, len(v))
+	sum := 0.0
+	for i, val := range v {
+		switch {
+		case i < window-1:
+			sum += float64(val)
+			result[i] = math.NaN()
+		case i == window-1:
+			sum += float64(val)
+			result[i] = sum / float64(window)
+		default:
+			result[i] = alpha*float64(val) + (1-alpha)*result[i-1]
+		}
+	}
+
+	return result
+}
+
+// RollingApply applies the function fn to each window of consecutive
+// values and returns the results.
+//
+// The result is aligned with the input: the element with index i is
+// fn applied to the window of values ending at index i. The first
+// window-1 elements, for which the window is incomplete, are the zero
+// values of type U. The fn must not modify or retain the window slice.
+//
+// The function returns nil if the window is less than or equal to zero.
+//
+// Example usage:
+//
+//	g.RollingApply([]int{1, 5, 2, 8, 3}, 2, func(w []int) int {
+//	    return g.Max(w...)
+//	})
+//	// Output: [0 5 5 8 8]
+func RollingApply[T any, U any](v []T, window int, fn func([]T) U) []U {
+	if window <= 0 {
+		return nil
+	}
+
+	result := make([]U, len(v))
+	for i := window - 1; i < len(v); i++ {
+		result[i] = fn(v[i-window+1 : i+1 : i+1])
+	}
+
+	return result
+}
@@ -0,0 +1,160 @@
+package g
+
+import (
+	"math"
+	"reflect"
+	"testing"
+)
+
+// equalWithNaN checks that two float64 slices are equal with a small
+// tolerance, treating NaN values as equal to each other.
+func equalWithNaN(a, b []float64) bool {
+	if len(a) != len(b) {
+		return false
+	}
+
+	for i := range a {
+		if math.IsNaN(a[i]) || math.IsNaN(b[i]) {
+			if !math.IsNaN(a[i]) || !math.IsNaN(b[i]) {
+				return false
+			}
+		} else if math.Abs(a[i]-b[i]) > 1e-9 {
+			return false
+		}
+	}
+
+	return true
+}
+
+// TestCumulative tests the CumSum, CumProd, CumMax and CumMin functions.
+func TestCumulative(t *testing.T) {
+	tests := []struct {
+		name string
+		fn   func([]int) []int
+		v    []int
+		want []int
+	}{
+		{"CumSum", CumSum[int], []int{1, 2, 3, 4}, []int{1, 3, 6, 10}},
+		{"CumSum empty", CumSum[int], []int{}, []int{}},
+		{"CumProd", CumProd[int], []int{1, 2, 3, 4}, []int{1, 2, 6, 24}},
+		{"CumMax", CumMax[int], []int{1, 3, 2, 5, 4}, []int{1, 3, 3, 5, 5}},
+		{"CumMin", CumMin[int], []int{5, 3, 4, 1, 2}, []int{5, 3, 3, 1, 1}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.fn(tt.v); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("%s() = %v, want %v", tt.name, got, tt.want)
+			}
+		})
+	}
+}
+
+// TestDeltas tests the Deltas function.
+func TestDeltas(t *testing.T) {
+	if got := Deltas([]int{1, 4, 9, 16}); !reflect.DeepEqual(
+		got, []int{0, 3, 5, 7}) {
+		t.Errorf("Deltas() = %v, want [0 3 5 7]", got)
+	}
+
+	if got := Deltas([]float64{}); len(got) != 0 {
+		t.Errorf("Deltas() = %v, want []", got)
+	}
+}
+
+// TestMovingAverage tests the moving average functions.
+func TestMovingAverage(t *testing.T) {
+	nan := math.NaN()
+	v := []int{1, 2, 3, 4, 5}
+	tests := []struct {
+		name string
+		got  []float64
+		want []float64
+	}{
+		{
+			"Simple",
+			MovingAverage(v, 3),
+			[]float64{nan, nan, 2, 3, 4},
+		},
+		{
+			"Simple window 1",
+			MovingAverage(v, 1),
+			[]float64{1, 2, 3, 4, 5},
+		},
+		{
+			"Simple large window",
+			MovingAverage(v, 10),
+			[]float64{nan, nan, nan, nan, nan},
+		},
+		{
+			"Simple precision",
+			MovingAverage([]float64{1e16, 1, 1, 1}, 1),
+			[]float64{1e16, 1, 1, 1},
+		},
+		{
+			"Simple infinity leaves the window",
+			MovingAverage([]float64{1, math.Inf(1), 3, 5, 7}, 2),
+			[]float64{nan, math.Inf(1), math.Inf(1), 4, 6},
+		},
+		{
+			"Simple NaN leaves the window",
+			MovingAverage([]float64{1, math.NaN(), 3, 5}, 2),
+			[]float64{nan, nan, nan, 4},
+		},
+		{
+			"Simple opposite infinities",
+			MovingAverage([]float64{math.Inf(1), math.Inf(-1), 2, 4}, 2),
+			[]float64{nan, nan, math.Inf(-1), 3},
+		},
+		{
+			"Weighted",
+			WeightedMovingAverage([]int{1, 2, 3, 4}, 2),
+			[]float64{nan, 5.0 / 3, 8.0 / 3, 11.0 / 3},
+		},
+		{
+			"Exponential",
+			ExponentialMovingAverage([]int{2, 4, 6, 8}, 3),
+			[]float64{nan, nan, 4, 6},
+		},
+		{
+			"Exponential flat",
+			ExponentialMovingAverage([]float64{5, 5, 5, 5}, 2),
+			[]float64{nan, 5, 5, 5},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if !equalWithNaN(tt.got, tt.want) {
+				t.Errorf("got %v, want %v", tt.got, tt.want)
+			}
+		})
+	}
+
+	if MovingAverage(v, 0) != nil ||
+		WeightedMovingAverage(v, -1) != nil ||
+		ExponentialMovingAverage(v, 0) != nil {
+		t.Errorf("expected nil for a non-positive window")
+	}
+}
+
+// TestRollingApply tests the RollingApply function.
+func TestRollingApply(t *testing.T) {
+	got := RollingApply([]int{1, 5, 2, 8, 3}, 2, func(w []int) int {
+		return Max(w...)
+	})
+	if want := []int{0, 5, 5, 8, 8}; !reflect.DeepEqual(got, want) {
+		t.Errorf("RollingApply() = %v, want %v", got, want)
+	}
+
+	lengths := RollingApply([]string{"a", "b", "c"}, 3, func(w []string) int {
+		return len(w)
+	})
+	if want := []int{0, 0, 3}; !reflect.DeepEqual(lengths, want) {
+		t.Errorf("RollingApply() = %v, want %v", lengths, want)
+	}
+
+	if got := RollingApply([]int{1}, 0, func(w []int) int { return 0 }); got != nil {
+		t.Errorf("RollingApply() = %v, want nil", got)
+	}
+}
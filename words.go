@@ -0,0 +1,489 @@
+package g
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// Gender is a grammatical gender of the counted noun, it defines
+// the forms of numerals in languages like Ukrainian.
+type Gender int
+
+const (
+	// Masculine gender, e.g. "один долар", "1-й".
+	Masculine Gender = iota
+
+	// Feminine gender, e.g. "одна гривня", "1-а".
+	Feminine
+
+	// Neuter gender, e.g. "одне євро", "1-е".
+	Neuter
+)
+
+// GrammaticalCase is a grammatical case of numerals
+// in languages like Ukrainian.
+type GrammaticalCase int
+
+const (
+	// Nominative case (називний), e.g. "дві тисячі".
+	Nominative GrammaticalCase = iota
+
+	// Genitive case (родовий), e.g. "двох тисяч".
+	Genitive
+
+	// Dative case (давальний), e.g. "двом тисячам".
+	Dative
+
+	// Accusative case (знахідний), e.g. "дві тисячі".
+	Accusative
+
+	// Instrumental case (орудний), e.g. "двома тисячами".
+	Instrumental
+
+	// Locative case (місцевий), e.g. "двох тисячах".
+	Locative
+)
+
+// WordsOptions is a set of options for the NumberToWords
+// and Ordinal functions. The options are ignored for
+// languages without grammatical gender and cases.
+type WordsOptions struct {
+	// Gender is the gender of the counted noun.
+	Gender Gender
+
+	// Case is the grammatical case of the numeral.
+	Case GrammaticalCase
+}
+
+var (
+	// The enOnes is a list of English words for numbers 0-19.
+	enOnes = []string{
+		"zero", "one", "two", "three", "four", "five", "six", "seven",
+		"eight", "nine", "ten", "eleven", "twelve", "thirteen", "fourteen",
+		"fifteen", "sixteen", "seventeen", "eighteen", "nineteen",
+	}
+
+	// The enTens is a list of English words for tens.
+	enTens = []string{
+		"", "", "twenty", "thirty", "forty", "fifty", "sixty", "seventy",
+		"eighty", "ninety",
+	}
+
+	// The enScales is a list of English words for powers of thousand.
+	enScales = []string{
+		"", "thousand", "million", "billion", "trillion", "quadrillion",
+		"quintillion",
+	}
+
+	// The ukOnes is a list of Ukrainian numerals 0-19 in all cases
+	// (nominative, genitive, dative, accusative, instrumental,
+	// locative). The 1 and 2 are in the masculine gender.
+	ukOnes = [][6]string{
+		{"нуль", "нуля", "нулю", "нуль", "нулем", "нулі"},
+		{"один", "одного", "одному", "один", "одним", "одному"},
+		{"два", "двох", "двом", "два", "двома", "двох"},
+		{"три", "трьох", "трьом", "три", "трьома", "трьох"},
+		{"чотири", "чотирьох", "чотирьом", "чотири", "чотирма", "чотирьох"},
+		ukRegularCases("п'ять"),
+		{"шість", "шести", "шести", "шість", "шістьма", "шести"},
+		{"сім", "семи", "семи", "сім", "сьома", "семи"},
+		{"вісім", "восьми", "восьми", "вісім", "вісьмома", "восьми"},
+		ukRegularCases("дев'ять"),
+		ukRegularCases("десять"),
+		ukRegularCases("одинадцять"),
+		ukRegularCases("дванадцять"),
+		ukRegularCases("тринадцять"),
+		ukRegularCases("чотирнадцять"),
+		ukRegularCases("п'ятнадцять"),
+		ukRegularCases("шістнадцять"),
+		ukRegularCases("сімнадцять"),
+		ukRegularCases("вісімнадцять"),
+		ukRegularCases("дев'ятнадцять"),
+	}
+
+	// The ukOneFeminine and ukOneNeuter are the forms of 1
+	// in the feminine and neuter genders.
+	ukOneFeminine = [6]string{"одна", "однієї", "одній", "одну", "однією", "одній"}
+	ukOneNeuter   = [6]string{"одне", "одного", "одному", "одне", "одним", "одному"}
+
+	// The ukTwoFeminine is the form of 2 in the feminine gender.
+	ukTwoFeminine = [6]string{"дві", "двох", "двом", "дві", "двома", "двох"}
+
+	// The ukTens is a list of Ukrainian tens in all cases.
+	ukTens = [][6]string{
+		{}, {},
+		ukRegularCases("двадцять"),
+		ukRegularCases("тридцять"),
+		{"сорок", "сорока", "сорока", "сорок", "сорока", "сорока"},
+		ukRegularCases("п'ятдесят"),
+		ukRegularCases("шістдесят"),
+		ukRegularCases("сімдесят"),
+		ukRegularCases("вісімдесят"),
+		{"дев'яносто", "дев'яноста", "дев'яноста", "дев'яносто",
+			"дев'яноста", "дев'яноста"},
+	}
+
+	// The ukHundreds is a list of Ukrainian hundreds in all cases.
+	ukHundreds = [][6]string{
+		{},
+		{"сто", "ста", "ста", "сто", "ста", "ста"},
+		{"двісті", "двохсот", "двомстам", "двісті", "двомастами", "двохстах"},
+		{"триста", "трьохсот", "трьомстам", "триста", "трьомастами",
+			"трьохстах"},
+		{"чотириста", "чотирьохсот", "чотирьомстам", "чотириста",
+			"чотирмастами", "чотирьохстах"},
+		{"п'ятсот", "п'ятисот", "п'ятистам", "п'ятсот", "п'ятьмастами",
+			"п'ятистах"},
+		{"шістсот", "шестисот", "шестистам", "шістсот", "шістьмастами",
+			"шестистах"},
+		{"сімсот", "семисот", "семистам", "сімсот", "сьомастами", "семистах"},
+		{"вісімсот", "восьмисот", "восьмистам", "вісімсот", "вісьмастами",
+			"восьмистах"},
+		{"дев'ятсот", "дев'ятисот", "дев'ятистам", "дев'ятсот",
+			"дев'ятьмастами", "дев'ятистах"},
+	}
+
+	// The ukScales is a list of Ukrainian nouns for powers of thousand
+	// in singular and plural forms in all cases.
+	ukScales = []ukScale{
+		{},
+		{
+			Feminine,
+			[6]string{"тисяча", "тисячі", "тисячі", "тисячу", "тисячею",
+				"тисячі"},
+			[6]string{"тисячі", "тисяч", "тисячам", "тисячі", "тисячами",
+				"тисячах"},
+		},
+		ukMasculineScale("мільйон"),
+		ukMasculineScale("мільярд"),
+		ukMasculineScale("трильйон"),
+		ukMasculineScale("квадрильйон"),
+		ukMasculineScale("квінтильйон"),
+	}
+)
+
+// The ukScale is a Ukrainian noun for a power of thousand,
+// e.g. тисяча or мільйон, with its gender and case forms.
+type ukScale struct {
+	gender   Gender
+	singular [6]string
+	plural   [6]string
+}
+
+// The ukRegularCases function returns the case forms of Ukrainian
+// numerals ending in -ть or -т, like "п'ять" or "п'ятдесят".
+func ukRegularCases(nominative string) [6]string {
+	stem := strings.TrimSuffix(nominative, "ь")
+	return [6]string{
+		nominative,
+		stem + "и",
+		stem + "и",
+		nominative,
+		stem + "ьма",
+		stem + "и",
+	}
+}
+
+// The ukMasculineScale function returns the declension of Ukrainian
+// masculine nouns for powers of thousand, like "мільйон".
+func ukMasculineScale(stem string) ukScale {
+	return ukScale{
+		Masculine,
+		[6]string{stem, stem + "а", stem + "у", stem, stem + "ом", stem + "і"},
+		[6]string{stem + "и", stem + "ів", stem + "ам", stem + "и",
+			stem + "ами", stem + "ах"},
+	}
+}
+
+// The wordsLanguage function returns the language part
+// of the locale name, e.g. "uk" for "uk-UA".
+func wordsLanguage(locale string) string {
+	locale = strings.ToLower(locale)
+	if i := strings.IndexAny(locale, "-_"); i > 0 {
+		return locale[:i]
+	}
+
+	return locale
+}
+
+// The enGroupWords function returns English words for 1-999.
+func enGroupWords(n uint64) []string {
+	var words []string
+	if h := n / 100; h > 0 {
+		words = append(words, enOnes[h], "hundred")
+	}
+
+	switch r := n % 100; {
+	case r >= 20 && r%10 != 0:
+		words = append(words, enTens[r/10]+"-"+enOnes[r%10])
+	case r >= 20:
+		words = append(words, enTens[r/10])
+	case r > 0:
+		words = append(words, enOnes[r])
+	}
+
+	return words
+}
+
+// The ukUnitWord function returns the Ukrainian word for 1-19
+// in the given gender and case.
+func ukUnitWord(n uint64, gender Gender, c GrammaticalCase) string {
+	switch {
+	case n == 1 && gender == Feminine:
+		return ukOneFeminine[c]
+	case n == 1 && gender == Neuter:
+		return ukOneNeuter[c]
+	case n == 2 && gender == Feminine:
+		return ukTwoFeminine[c]
+	}
+
+	return ukOnes[n][c]
+}
+
+// The ukGroupWords function returns Ukrainian words for 1-999
+// in the given gender and case.
+func ukGroupWords(n uint64, gender Gender, c GrammaticalCase) []string {
+	var words []string
+	if h := n / 100; h > 0 {
+		words = append(words, ukHundreds[h][c])
+	}
+
+	switch r := n % 100; {
+	case r >= 20:
+		words = append(words, ukTens[r/10][c])
+		if r%10 != 0 {
+			words = append(words, ukUnitWord(r%10, gender, c))
+		}
+	case r > 0:
+		words = append(words, ukUnitWord(r, gender, c))
+	}
+
+	return words
+}
+
+// The ukScaleWord function returns the form of the Ukrainian noun for
+// the power of thousand that agrees with the number n and the case.
+func ukScaleWord(scale int, n uint64, c GrammaticalCase) string {
+	s := ukScales[scale]
+	last, lastTwo := n%10, n%100
+	if c == Nominative || c == Accusative {
+		switch {
+		case lastTwo >= 11 && lastTwo <= 14:
+			return s.plural[Genitive]
+		case last == 1:
+			return s.singular[c]
+		case last >= 2 && last <= 4:
+			return s.plural[c]
+		}
+		return s.plural[Genitive]
+	}
+
+	if last == 1 && lastTwo != 11 {
+		return s.singular[c]
+	}
+
+	return s.plural[c]
+}
+
+// NumberToWords spells out an integer in words in the language
+// of the locale, e.g. for amounts in invoices and legal documents.
+//
+// Supported languages are English ("en") and Ukrainian ("uk").
+// For Ukrainian, the options define the gender of the counted noun and
+// the grammatical case, and all parts of the numeral agree with them,
+// including the forms of тисяча, мільйон and so on.
+//
+// Example usage:
+//
+//	g.NumberToWords(1234, "en")
+//	// Output: "one thousand two hundred thirty-four", nil
+//
+//	g.NumberToWords(2021, "uk", g.WordsOptions{Gender: g.Feminine})
+//	// Output: "дві тисячі двадцять одна", nil
+//
+//	g.NumberToWords(2500, "uk", g.WordsOptions{Case: g.Genitive})
+//	// Output: "двох тисяч п'ятисот", nil
+func NumberToWords[T Integer](
+	v T,
+	locale string,
+	opts ...WordsOptions,
+) (string, error) {
+	var opt WordsOptions
+	if len(opts) > 0 {
+		opt = opts[0]
+	}
+
+	if opt.Case < Nominative || opt.Case > Locative {
+		return "", fmt.Errorf("invalid grammatical case %d", opt.Case)
+	}
+
+	lang := wordsLanguage(locale)
+	if lang != "en" && lang != "uk" {
+		return "", fmt.Errorf("unsupported language %q", locale)
+	}
+
+	m := magnitude(v)
+	if m == 0 {
+		return If(lang == "en", enOnes[0], ukOnes[0][opt.Case]), nil
+	}
+
+	// Split into groups of three digits, the lowest first.
+	var groups []uint64
+	for ; m > 0; m /= 1000 {
+		groups = append(groups, m%1000)
+	}
+
+	var words []string
+	if v < 0 {
+		words = append(words, If(lang == "en", "minus", "мінус"))
+	}
+
+	for scale := len(groups) - 1; scale >= 0; scale-- {
+		n := groups[scale]
+		if n == 0 {
+			continue
+		}
+
+		if lang == "en" {
+			words = append(words, enGroupWords(n)...)
+			if scale > 0 {
+				words = append(words, enScales[scale])
+			}
+			continue
+		}
+
+		gender := opt.Gender
+		if scale > 0 {
+			gender = ukScales[scale].gender
+		}
+
+		words = append(words, ukGroupWords(n, gender, opt.Case)...)
+		if scale > 0 {
+			words = append(words, ukScaleWord(scale, n, opt.Case))
+		}
+	}
+
+	return strings.Join(words, " "), nil
+}
+
+// Ordinal returns the ordinal form of an integer written in digits,
+// in the language of the locale.
+//
+// For English ("en"), the suffixes st, nd, rd and th are used. For
+// Ukrainian ("uk"), the suffix depends on the gender from the options:
+// "-й" for masculine, "-а" for feminine and "-е" for neuter, or "-я"
+// and "-є" if the number ends in 3 and is read as "третя" or "третє"
+// (but 13 is "тринадцята", "13-а").
+// If the language is not supported, the English form is returned.
+//
+// Example usage:
+//
+//	g.Ordinal(1, "en")   // Output: "1st"
+//	g.Ordinal(12, "en")  // Output: "12th"
+//	g.Ordinal(23, "en")  // Output: "23rd"
+//	g.Ordinal(1, "uk")   // Output: "1-й"
+//	g.Ordinal(3, "uk", g.WordsOptions{Gender: g.Feminine}) // Output: "3-я"
+//	g.Ordinal(5, "uk", g.WordsOptions{Gender: g.Neuter})   // Output: "5-е"
+func Ordinal[T Integer](v T, locale string, opts ...WordsOptions) string {
+	var opt WordsOptions
+	if len(opts) > 0 {
+		opt = opts[0]
+	}
+
+	s := IntToString(v)
+	m := magnitude(v)
+	if wordsLanguage(locale) == "uk" {
+		third := m%10 == 3 && m%100 != 13
+		switch opt.Gender {
+		case Feminine:
+			return s + If(third, "-я", "-а")
+		case Neuter:
+			return s + If(third, "-є", "-е")
+		}
+		return s + "-й"
+	}
+
+	switch {
+	case m%100 >= 11 && m%100 <= 13:
+		return s + "th"
+	case m%10 == 1:
+		return s + "st"
+	case m%10 == 2:
+		return s + "nd"
+	case m%10 == 3:
+		return s + "rd"
+	}
+
+	return s + "th"
+}
+
+// The romanNumerals is a list of Roman numerals and their values
+// in descending order, including the subtractive forms.
+var romanNumerals = []Pair[int, string]{
+	{1000, "M"}, {900, "CM"}, {500, "D"}, {400, "CD"},
+	{100, "C"}, {90, "XC"}, {50, "L"}, {40, "XL"},
+	{10, "X"}, {9, "IX"}, {5, "V"}, {4, "IV"}, {1, "I"},
+}
+
+// IntToRoman converts an integer to a Roman numeral.
+//
+// Only values from 1 to 3999 can be written in the standard form,
+// for other values the function returns an error.
+//
+// Example usage:
+//
+//	g.IntToRoman(2024)  // Output: "MMXXIV", nil
+//	g.IntToRoman(0)     // Output: "", error
+func IntToRoman[T Integer](v T) (string, error) {
+	if v < 1 || magnitude(v) > 3999 {
+		return "", fmt.Errorf("value %d is out of range 1-3999", v)
+	}
+
+	var sb strings.Builder
+	n := int(v)
+	for _, r := range romanNumerals {
+		for n >= r.First {
+			sb.WriteString(r.Second)
+			n -= r.First
+		}
+	}
+
+	return sb.String(), nil
+}
+
+// RomanToInt converts a Roman numeral to an integer.
+//
+// The numeral is case-insensitive and must be written in the standard
+// form: "IV" is accepted but "IIII" or "IIV" are not. The function
+// returns an error for invalid numerals.
+//
+// Example usage:
+//
+//	g.RomanToInt("MMXXIV")  // Output: 2024, nil
+//	g.RomanToInt("mcmxc")   // Output: 1990, nil
+//	g.RomanToInt("IIII")    // Output: 0, error
+func RomanToInt(s string) (int, error) {
+	if s == "" {
+		return 0, errors.New("empty roman numeral")
+	}
+
+	upper := strings.ToUpper(s)
+	n, rest := 0, upper
+	for _, r := range romanNumerals {
+		for strings.HasPrefix(rest, r.Second) {
+			n += r.First
+			rest = rest[len(r.Second):]
+		}
+	}
+
+	// The numeral is valid only if it's written in the standard form,
+	// that is, it is equal to the result of the reverse conversion.
+	if expected, err := IntToRoman(n); rest != "" || err != nil ||
+		expected != upper {
+		return 0, fmt.Errorf("invalid roman numeral %s", strconv.Quote(s))
+	}
+
+	return n, nil
+}
@@ -0,0 +1,190 @@
+package g
+
+import (
+	"math"
+	"testing"
+)
+
+// TestNumberToWords tests the NumberToWords function.
+func TestNumberToWords(t *testing.T) {
+	tests := []struct {
+		name   string
+		value  int64
+		locale string
+		opts   []WordsOptions
+		want   string
+	}{
+		{"En zero", 0, "en", nil, "zero"},
+		{"En teen", 13, "en", nil, "thirteen"},
+		{"En tens", 40, "en", nil, "forty"},
+		{"En hyphen", 99, "en", nil, "ninety-nine"},
+		{"En thousands", 1234, "en-US", nil,
+			"one thousand two hundred thirty-four"},
+		{"En millions", 2000005, "en", nil, "two million five"},
+		{"En negative", -15, "en", nil, "minus fifteen"},
+		{"En min int64", math.MinInt64, "en", nil,
+			"minus nine quintillion two hundred twenty-three quadrillion " +
+				"three hundred seventy-two trillion thirty-six billion " +
+				"eight hundred fifty-four million seven hundred seventy-five " +
+				"thousand eight hundred eight"},
+		{"Uk zero", 0, "uk", nil, "нуль"},
+		{"Uk masculine", 21, "uk", nil, "двадцять один"},
+		{"Uk feminine", 22, "uk",
+			[]WordsOptions{{Gender: Feminine}}, "двадцять дві"},
+		{"Uk neuter", 1, "uk", []WordsOptions{{Gender: Neuter}}, "одне"},
+		{"Uk one thousand", 1000, "uk", nil, "одна тисяча"},
+		{"Uk thousands", 2021, "uk-UA",
+			[]WordsOptions{{Gender: Feminine}}, "дві тисячі двадцять одна"},
+		{"Uk many thousands", 5000, "uk", nil, "п'ять тисяч"},
+		{"Uk teen thousands", 12000, "uk", nil, "дванадцять тисяч"},
+		{"Uk millions", 3000000, "uk", nil, "три мільйони"},
+		{"Uk many millions", 11000000, "uk", nil, "одинадцять мільйонів"},
+		{"Uk hundreds", 345, "uk", nil, "триста сорок п'ять"},
+		{"Uk negative", -7, "uk", nil, "мінус сім"},
+		{"Uk genitive", 2500, "uk", []WordsOptions{{Case: Genitive}},
+			"двох тисяч п'ятисот"},
+		{"Uk dative", 41, "uk",
+			[]WordsOptions{{Gender: Feminine, Case: Dative}},
+			"сорока одній"},
+		{"Uk accusative", 1001, "uk",
+			[]WordsOptions{{Gender: Feminine, Case: Accusative}},
+			"одну тисячу одну"},
+		{"Uk instrumental", 2000000, "uk",
+			[]WordsOptions{{Case: Instrumental}}, "двома мільйонами"},
+		{"Uk locative", 1000, "uk", []WordsOptions{{Case: Locative}},
+			"одній тисячі"},
+		{"Uk genitive one million", 1000000, "uk",
+			[]WordsOptions{{Case: Genitive}}, "одного мільйона"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := NumberToWords(tt.value, tt.locale, tt.opts...)
+			if err != nil {
+				t.Fatalf("NumberToWords() error = %v", err)
+			}
+
+			if got != tt.want {
+				t.Errorf("NumberToWords() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+
+	if got, _ := NumberToWords(uint64(math.MaxUint64), "en"); got == "" {
+		t.Errorf("NumberToWords(MaxUint64) returned empty string")
+	}
+
+	if _, err := NumberToWords(1, "fr"); err == nil {
+		t.Errorf("NumberToWords() expected error for unsupported locale")
+	}
+
+	if _, err := NumberToWords(1, "uk", WordsOptions{Case: 10}); err == nil {
+		t.Errorf("NumberToWords() expected error for invalid case")
+	}
+}
+
+// TestOrdinal tests the Ordinal function.
+func TestOrdinal(t *testing.T) {
+	tests := []struct {
+		name string
+		got  string
+		want string
+	}{
+		{"1st", Ordinal(1, "en"), "1st"},
+		{"2nd", Ordinal(2, "en"), "2nd"},
+		{"3rd", Ordinal(3, "en"), "3rd"},
+		{"4th", Ordinal(4, "en"), "4th"},
+		{"11th", Ordinal(11, "en"), "11th"},
+		{"12th", Ordinal(uint8(12), "en"), "12th"},
+		{"13th", Ordinal(113, "en"), "113th"},
+		{"21st", Ordinal(21, "en"), "21st"},
+		{"102nd", Ordinal(102, "en"), "102nd"},
+		{"Negative", Ordinal(-1, "en"), "-1st"},
+		{"Unknown locale", Ordinal(2, "xx"), "2nd"},
+		{"Uk masculine", Ordinal(1, "uk"), "1-й"},
+		{"Uk feminine", Ordinal(5, "uk", WordsOptions{Gender: Feminine}),
+			"5-а"},
+		{"Uk neuter", Ordinal(5, "uk", WordsOptions{Gender: Neuter}), "5-е"},
+		{"Uk feminine 3", Ordinal(3, "uk", WordsOptions{Gender: Feminine}),
+			"3-я"},
+		{"Uk neuter 3", Ordinal(3, "uk", WordsOptions{Gender: Neuter}), "3-є"},
+		{"Uk masculine 3", Ordinal(3, "uk"), "3-й"},
+		{"Uk feminine 23", Ordinal(23, "uk", WordsOptions{Gender: Feminine}),
+			"23-я"},
+		{"Uk neuter 23", Ordinal(23, "uk", WordsOptions{Gender: Neuter}),
+			"23-є"},
+		{"Uk feminine 13", Ordinal(13, "uk", WordsOptions{Gender: Feminine}),
+			"13-а"},
+		{"Uk neuter 13", Ordinal(113, "uk", WordsOptions{Gender: Neuter}),
+			"113-е"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if tt.got != tt.want {
+				t.Errorf("Ordinal() = %q, want %q", tt.got, tt.want)
+			}
+		})
+	}
+}
+
+// TestIntToRoman tests the IntToRoman function.
+func TestIntToRoman(t *testing.T) {
+	tests := []struct {
+		value int
+		want  string
+	}{
+		{1, "I"},
+		{4, "IV"},
+		{9, "IX"},
+		{14, "XIV"},
+		{40, "XL"},
+		{90, "XC"},
+		{400, "CD"},
+		{1990, "MCMXC"},
+		{2024, "MMXXIV"},
+		{3999, "MMMCMXCIX"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.want, func(t *testing.T) {
+			got, err := IntToRoman(tt.value)
+			if err != nil || got != tt.want {
+				t.Errorf("IntToRoman(%d) = %q, %v, want %q",
+					tt.value, got, err, tt.want)
+			}
+		})
+	}
+
+	for _, v := range []int{0, -1, 4000} {
+		if _, err := IntToRoman(v); err == nil {
+			t.Errorf("IntToRoman(%d) expected error", v)
+		}
+	}
+
+	if _, err := IntToRoman(int8(100)); err != nil {
+		t.Errorf("IntToRoman(int8(100)) error = %v", err)
+	}
+}
+
+// TestRomanToInt tests the RomanToInt function.
+func TestRomanToInt(t *testing.T) {
+	for i := 1; i <= 3999; i++ {
+		s, _ := IntToRoman(i)
+		if got, err := RomanToInt(s); err != nil || got != i {
+			t.Fatalf("RomanToInt(%q) = %d, %v, want %d", s, got, err, i)
+		}
+	}
+
+	if got, err := RomanToInt("mcmxc"); err != nil || got != 1990 {
+		t.Errorf("RomanToInt(\"mcmxc\") = %d, %v", got, err)
+	}
+
+	invalid := []string{"", "IIII", "IIV", "VV", "IC", "MMMM", "XM", "ABC",
+		"X I"}
+	for _, s := range invalid {
+		if _, err := RomanToInt(s); err == nil {
+			t.Errorf("RomanToInt(%q) expected error", s)
+		}
+	}
+}